@@ -0,0 +1,175 @@
+/*  admin.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 19, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 19/10/26 11:20
+ */
+
+package suki
+
+import (
+        "crypto/subtle"
+        "fmt"
+        "net"
+        "net/http"
+        "net/http/pprof"
+        "net/url"
+        "runtime"
+        "runtime/debug"
+        "strings"
+        "time"
+)
+
+var startedAt = time.Now()
+
+// Route describes a registered route of a router.
+type Route struct {
+        Method  string `json:"method"`
+        Pattern string `json:"pattern"`
+}
+
+// RouteLister is implemented by routers able to list their routes,
+// such as ruuto.ChiRouter.
+type RouteLister interface {
+        Routes() []Route
+}
+
+type AdminOptions struct {
+        Token  string      // bearer token required by every admin request
+        Routes RouteLister // routes of the public handler, optional
+}
+
+// NewAdminHandler returns the handler of the admin server exposing pprof,
+// runtime stats, build info, registered routes and the log level.
+func NewAdminHandler(opts AdminOptions) http.Handler {
+        mux := http.NewServeMux()
+        mux.HandleFunc("/debug/pprof/", pprof.Index)
+        mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+        mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+        mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+        mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+        mux.HandleFunc("/debug/stats", adminStats)
+        mux.HandleFunc("/debug/buildinfo", adminBuildInfo)
+        mux.HandleFunc("/debug/routes", func(w http.ResponseWriter, r *http.Request) {
+                routes := make([]Route, 0)
+                if opts.Routes != nil {
+                        routes = opts.Routes.Routes()
+                }
+                res := Response()
+                res.Body(routes)
+                WriteJSON(w, r, res)
+        })
+        mux.Handle("/debug/log/level", level)
+        return adminAuth(opts.Token, mux)
+}
+
+// adminAuth allows requests carrying the bearer token, or only loopback
+// clients when no token is configured.
+func adminAuth(token string, next http.Handler) http.Handler {
+        return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+                if token != "" {
+                        bearer, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+                        if ok && subtle.ConstantTimeCompare([]byte(bearer), []byte(token)) == 1 {
+                                next.ServeHTTP(w, r)
+                                return
+                        }
+                } else if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil && isLoopback(host) {
+                        next.ServeHTTP(w, r)
+                        return
+                }
+                setStatus(w, r, StatusUnauthorized)
+                res := Response()
+                res.Errors(Meta{
+                        Code:    StatusCode(StatusUnauthorized),
+                        Message: StatusText(StatusUnauthorized),
+                })
+                WriteJSON(w, r, res)
+        })
+}
+
+func isLoopback(host string) bool {
+        if host == "localhost" {
+                return true
+        }
+        ip := net.ParseIP(host)
+        return ip != nil && ip.IsLoopback()
+}
+
+func adminStats(w http.ResponseWriter, r *http.Request) {
+        var m runtime.MemStats
+        runtime.ReadMemStats(&m)
+        res := Response()
+        res.Body(map[string]interface{}{
+                "uptime":       time.Since(startedAt).String(),
+                "goroutines":   runtime.NumGoroutine(),
+                "cpu":          runtime.NumCPU(),
+                "gomaxprocs":   runtime.GOMAXPROCS(0),
+                "cgo_calls":    runtime.NumCgoCall(),
+                "alloc":        m.Alloc,
+                "total_alloc":  m.TotalAlloc,
+                "sys":          m.Sys,
+                "mallocs":      m.Mallocs,
+                "frees":        m.Frees,
+                "heap_alloc":   m.HeapAlloc,
+                "heap_sys":     m.HeapSys,
+                "heap_idle":    m.HeapIdle,
+                "heap_inuse":   m.HeapInuse,
+                "heap_objects": m.HeapObjects,
+                "gc_num":       m.NumGC,
+                "gc_pause":     time.Duration(m.PauseTotalNs).String(),
+                "gc_last":      time.Unix(0, int64(m.LastGC)).UTC(),
+                "gc_next":      m.NextGC,
+        })
+        WriteJSON(w, r, res)
+}
+
+func adminBuildInfo(w http.ResponseWriter, r *http.Request) {
+        info, ok := debug.ReadBuildInfo()
+        if !ok {
+                setStatus(w, r, StatusInternalError)
+                res := Response()
+                res.Errors(Meta{
+                        Code:    StatusCode(StatusInternalError),
+                        Message: "build info is not available",
+                })
+                WriteJSON(w, r, res)
+                return
+        }
+        deps := make(map[string]string)
+        for _, dep := range info.Deps {
+                deps[dep.Path] = dep.Version
+        }
+        settings := make(map[string]string)
+        for _, s := range info.Settings {
+                settings[s.Key] = s.Value
+        }
+        res := Response()
+        res.Body(map[string]interface{}{
+                "go_version": info.GoVersion,
+                "path":       info.Path,
+                "version":    info.Main.Version,
+                "settings":   settings,
+                "deps":       deps,
+        })
+        WriteJSON(w, r, res)
+}
+
+// StartAdminServer starts the admin server on addr. Without a token the
+// server must be bound to a loopback address.
+func StartAdminServer(addr string, opts AdminOptions) (*Server, error) {
+        host, _, err := net.SplitHostPort(addr)
+        if err != nil {
+                return nil, err
+        }
+        if opts.Token == "" && !isLoopback(host) {
+                return nil, fmt.Errorf("admin server on %s requires a token or a loopback address", addr)
+        }
+        addrURL := url.URL{Scheme: "http", Host: addr}
+        listener, err := Listen(addrURL, DefaultSocketMode)
+        if err != nil {
+                return nil, err
+        }
+        Info(fmt.Sprintf("started admin server %s", addrURL.String()))
+        return StartWebServerListener(listener, addrURL, 0, 0, NewAdminHandler(opts)), nil
+}
@@ -0,0 +1,102 @@
+/*  admin_test.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 19, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 19/10/26 11:48
+ */
+
+package suki
+
+import (
+        "encoding/json"
+        "net/http"
+        "net/http/httptest"
+        "strings"
+        "testing"
+
+        "github.com/stretchr/testify/assert"
+        "github.com/stretchr/testify/require"
+        "go.uber.org/zap/zapcore"
+)
+
+type routeList []Route
+
+func (r routeList) Routes() []Route {
+        return r
+}
+
+func TestAdminToken(t *testing.T) {
+        handler := NewAdminHandler(AdminOptions{Token: "sekret"})
+
+        r := httptest.NewRequest(http.MethodGet, "/debug/stats", nil)
+        w := httptest.NewRecorder()
+        handler.ServeHTTP(w, r)
+        assert.Equal(t, StatusUnauthorized, w.Code)
+
+        for _, auth := range []string{"sekret", "Basic sekret", "Bearer sekre"} {
+                r = httptest.NewRequest(http.MethodGet, "/debug/stats", nil)
+                r.Header.Set("Authorization", auth)
+                w = httptest.NewRecorder()
+                handler.ServeHTTP(w, r)
+                assert.Equal(t, StatusUnauthorized, w.Code, auth)
+        }
+
+        r = httptest.NewRequest(http.MethodGet, "/debug/stats", nil)
+        r.Header.Set("Authorization", "Bearer sekret")
+        w = httptest.NewRecorder()
+        handler.ServeHTTP(w, r)
+        assert.Equal(t, StatusSuccess, w.Code)
+
+        var body struct {
+                Data map[string]interface{} `json:"data"`
+        }
+        require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+        assert.Contains(t, body.Data, "goroutines")
+        assert.Contains(t, body.Data, "heap_alloc")
+}
+
+func TestAdminLoopback(t *testing.T) {
+        handler := NewAdminHandler(AdminOptions{
+                Routes: routeList{{Method: http.MethodGet, Pattern: "/users"}},
+        })
+
+        r := httptest.NewRequest(http.MethodGet, "/debug/routes", nil)
+        r.RemoteAddr = "10.1.2.3:4567"
+        w := httptest.NewRecorder()
+        handler.ServeHTTP(w, r)
+        assert.Equal(t, StatusUnauthorized, w.Code)
+
+        r = httptest.NewRequest(http.MethodGet, "/debug/routes", nil)
+        r.RemoteAddr = "127.0.0.1:4567"
+        w = httptest.NewRecorder()
+        handler.ServeHTTP(w, r)
+        assert.Equal(t, StatusSuccess, w.Code)
+        assert.Contains(t, w.Body.String(), `{"method":"GET","pattern":"/users"}`)
+}
+
+func TestAdminLogLevel(t *testing.T) {
+        defer Level().SetLevel(zapcore.DebugLevel)
+        handler := NewAdminHandler(AdminOptions{Token: "sekret"})
+
+        r := httptest.NewRequest(http.MethodPut, "/debug/log/level", strings.NewReader(`{"level":"warn"}`))
+        r.Header.Set("Authorization", "Bearer sekret")
+        w := httptest.NewRecorder()
+        handler.ServeHTTP(w, r)
+        assert.Equal(t, StatusSuccess, w.Code)
+        assert.Equal(t, zapcore.WarnLevel, Level().Level())
+}
+
+func TestStartAdminServer(t *testing.T) {
+        _, err := StartAdminServer("0.0.0.0:0", AdminOptions{})
+        assert.Error(t, err, "public admin server without token")
+
+        srv, err := StartAdminServer("127.0.0.1:0", AdminOptions{})
+        require.NoError(t, err)
+        defer srv.Stop()
+
+        res, err := http.Get("http://" + srv.Listener().Addr().String() + "/debug/pprof/")
+        require.NoError(t, err)
+        defer res.Body.Close()
+        assert.Equal(t, StatusSuccess, res.StatusCode)
+}
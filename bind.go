@@ -0,0 +1,250 @@
+/*  bind.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 20, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 24/10/26 11:20
+ */
+
+package suki
+
+import (
+        "encoding/json"
+        "errors"
+        "fmt"
+        "io"
+        "mime"
+        "mime/multipart"
+        "net/http"
+        "reflect"
+        "strings"
+
+        "github.com/go-chi/chi"
+)
+
+// DefaultMaxBodySize is the largest request body read by Bind.
+var DefaultMaxBodySize int64 = 10 << 20
+
+var (
+        fileHeaderType  = reflect.TypeOf((*multipart.FileHeader)(nil))
+        fileHeadersType = reflect.TypeOf([]*multipart.FileHeader(nil))
+)
+
+type bindOptions struct {
+        writer          http.ResponseWriter
+        maxBodySize     int64
+        disallowUnknown bool
+        skipValidation  bool
+}
+
+type BindOption func(*bindOptions)
+
+// MaxBodySize limits the request body, a larger body is answered with 413.
+func MaxBodySize(n int64) BindOption {
+        return func(o *bindOptions) {
+                o.maxBodySize = n
+        }
+}
+
+// ResponseWriter passes the response writer of the request to
+// http.MaxBytesReader, so the server closes the connection after a body
+// larger than MaxBodySize instead of reading the rest of it.
+func ResponseWriter(w http.ResponseWriter) BindOption {
+        return func(o *bindOptions) {
+                o.writer = w
+        }
+}
+
+// DisallowUnknownFields rejects a JSON body with fields missing in dst.
+func DisallowUnknownFields() BindOption {
+        return func(o *bindOptions) {
+                o.disallowUnknown = true
+        }
+}
+
+// SkipValidation binds without running Validate on dst.
+func SkipValidation() BindOption {
+        return func(o *bindOptions) {
+                o.skipValidation = true
+        }
+}
+
+// Bind decodes the request into dst, a pointer to a struct, and validates it.
+// The body is decoded by its content type: JSON with the json tags, url
+// encoded and multipart forms with the form tags. Query parameters are set on
+// the fields with a query tag and ruuto url parameters on the fields with a
+// param tag, e.g. `query:"from,format=2006-01-02"` or `param:"id"`.
+//
+// The error is an AppError, ErrBadRequest with every invalid field as a meta
+// entry, so it can be passed to WriteError or WriteJSON as it is.
+func Bind(r *http.Request, dst interface{}, opts ...BindOption) error {
+        o := &bindOptions{maxBodySize: DefaultMaxBodySize}
+        for _, opt := range opts {
+                opt(o)
+        }
+        v := reflect.ValueOf(dst)
+        if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+                return fmt.Errorf("bind: cannot bind into %T, want a pointer to a struct", dst)
+        }
+        locale := Locale(r.Context())
+        if err := bindBody(r, dst, locale, o); err != nil {
+                return err
+        }
+        errs := make([]Meta, 0)
+        if r.MultipartForm != nil {
+                errs = append(errs, bindValues(v.Elem(), "form", locale, r.MultipartForm.Value)...)
+                bindFiles(v.Elem(), r.MultipartForm.File)
+        } else if r.PostForm != nil {
+                errs = append(errs, bindValues(v.Elem(), "form", locale, r.PostForm)...)
+        }
+        errs = append(errs, bindValues(v.Elem(), "query", locale, r.URL.Query())...)
+        // chi.RouteContext panics outside of a router in this version
+        if rctx, ok := r.Context().Value(chi.RouteCtxKey).(*chi.Context); ok {
+                params := make(map[string][]string)
+                for i, key := range rctx.URLParams.Keys {
+                        params[key] = append(params[key], rctx.URLParams.Values[i])
+                }
+                errs = append(errs, bindValues(v.Elem(), "param", locale, params)...)
+        }
+        if len(errs) == 0 && !o.skipValidation {
+                errs = ValidateCtx(r.Context(), dst)
+        }
+        if len(errs) > 0 {
+                return ErrBadRequest.WithDetails(errs...)
+        }
+        return nil
+}
+
+func bindBody(r *http.Request, dst interface{}, locale string, o *bindOptions) error {
+        if r.Body == nil || r.Body == http.NoBody || r.ContentLength == 0 {
+                return nil
+        }
+        if r.ContentLength > o.maxBodySize {
+                return ErrRequestTooLarge
+        }
+        r.Body = http.MaxBytesReader(o.writer, r.Body, o.maxBodySize)
+        mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+        switch {
+        case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+                return bindJSON(r.Body, dst, locale, o)
+        case mediaType == "application/x-www-form-urlencoded":
+                return bodyError(r.ParseForm(), locale)
+        case mediaType == "multipart/form-data":
+                return bodyError(r.ParseMultipartForm(o.maxBodySize), locale)
+        }
+        return ErrUnsupportedMedia.WithDetails(Meta{
+                Code:    "Content-Type",
+                Type:    "header",
+                Message: T(locale, MsgUnsupportedMedia, mediaType),
+        })
+}
+
+func bindJSON(body io.Reader, dst interface{}, locale string, o *bindOptions) error {
+        dec := json.NewDecoder(body)
+        if o.disallowUnknown {
+                dec.DisallowUnknownFields()
+        }
+        err := dec.Decode(dst)
+        if err == nil || err == io.EOF {
+                return nil
+        }
+        var typeErr *json.UnmarshalTypeError
+        if errors.As(err, &typeErr) {
+                return ErrBadRequest.WithDetails(Meta{
+                        Code:    typeErr.Field,
+                        Type:    typeErr.Type.String(),
+                        Message: T(locale, MsgInvalidInput, typeErr.Value, typeErr.Field),
+                })
+        }
+        // the decoder has no error type for an unknown field
+        if msg := err.Error(); strings.HasPrefix(msg, "json: unknown field ") {
+                field := strings.Trim(strings.TrimPrefix(msg, "json: unknown field "), `"`)
+                return ErrBadRequest.WithDetails(Meta{
+                        Code:    field,
+                        Type:    "unknown",
+                        Message: T(locale, MsgUnknownInput, field),
+                })
+        }
+        return bodyError(err, locale)
+}
+
+// bodyError maps an error reading the body to the AppError answered.
+func bodyError(err error, locale string) error {
+        if err == nil {
+                return nil
+        }
+        var tooLarge *http.MaxBytesError
+        if errors.As(err, &tooLarge) {
+                return ErrRequestTooLarge.Wrap(err)
+        }
+        return ErrBadRequest.Wrap(err).WithDetails(Meta{
+                Code:    "body",
+                Type:    "body",
+                Message: T(locale, MsgMalformedBody),
+        })
+}
+
+// bindValues sets the fields of v tagged with tag from values,
+// a slice field takes every value of the key.
+func bindValues(v reflect.Value, tag, locale string, values map[string][]string) []Meta {
+        errs := make([]Meta, 0)
+        t := v.Type()
+        for i := 0; i < t.NumField(); i++ {
+                f := t.Field(i)
+                if f.PkgPath != "" {
+                        continue // unexported
+                }
+                field := v.Field(i)
+                if f.Anonymous && field.Kind() == reflect.Struct {
+                        errs = append(errs, bindValues(field, tag, locale, values)...)
+                        continue
+                }
+                name, format := splitTag(f.Tag.Get(tag))
+                if name == "" || name == "-" {
+                        continue
+                }
+                vals, ok := values[name]
+                if !ok || len(vals) == 0 {
+                        continue
+                }
+                invalid := func(value string) {
+                        errs = append(errs, Meta{
+                                Code:    name,
+                                Type:    f.Type.String(),
+                                Message: T(locale, MsgInvalidInput, value, name),
+                        })
+                }
+                if field.Kind() == reflect.Slice && field.Type().Elem().Kind() != reflect.Uint8 {
+                        slice := reflect.MakeSlice(field.Type(), len(vals), len(vals))
+                        for j, value := range vals {
+                                if err := parseValue(slice.Index(j), value, format); err != nil {
+                                        invalid(value)
+                                }
+                        }
+                        field.Set(slice)
+                        continue
+                }
+                if err := parseValue(field, vals[0], format); err != nil {
+                        invalid(vals[0])
+                }
+        }
+        return errs
+}
+
+// bindFiles sets the *multipart.FileHeader fields tagged with form.
+func bindFiles(v reflect.Value, files map[string][]*multipart.FileHeader) {
+        t := v.Type()
+        for i := 0; i < t.NumField(); i++ {
+                f := t.Field(i)
+                name, _ := splitTag(f.Tag.Get("form"))
+                if f.PkgPath != "" || name == "" || len(files[name]) == 0 {
+                        continue
+                }
+                switch f.Type {
+                case fileHeaderType:
+                        v.Field(i).Set(reflect.ValueOf(files[name][0]))
+                case fileHeadersType:
+                        v.Field(i).Set(reflect.ValueOf(files[name]))
+                }
+        }
+}
@@ -0,0 +1,173 @@
+/*  bind_test.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 20, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 24/10/26 11:30
+ */
+
+package suki
+
+import (
+        "bytes"
+        "context"
+        "errors"
+        "io"
+        "mime/multipart"
+        "net/http"
+        "net/http/httptest"
+        "net/url"
+        "strings"
+        "testing"
+        "time"
+
+        "github.com/go-chi/chi"
+        "github.com/stretchr/testify/assert"
+        "github.com/stretchr/testify/require"
+)
+
+type deliveryForm struct {
+        ID        int                     `param:"id"`
+        From      time.Time               `query:"from,format=2006-01-02"`
+        Areas     []string                `query:"area"`
+        Email     string                  `json:"email" form:"email" validate:"required,email"`
+        CourierID int                     `json:"courier_id" form:"courier_id" validate:"required,gt=1"`
+        Note      *string                 `json:"note" form:"note"`
+        Proof     *multipart.FileHeader   `form:"proof"`
+        Photos    []*multipart.FileHeader `form:"photo"`
+}
+
+func withParam(r *http.Request, key, value string) *http.Request {
+        rctx := chi.NewRouteContext()
+        rctx.URLParams.Add(key, value)
+        return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+}
+
+func TestBindJSON(t *testing.T) {
+        r := httptest.NewRequest(http.MethodPost, "/deliveries/17?from=2019-11-21&area=JKT&area=BDG",
+                strings.NewReader(`{"email":"nanang.jobs@gmail.com","courier_id":17,"note":"pagi"}`))
+        r.Header.Set("Content-Type", "application/json; charset=utf-8")
+        r = withParam(r, "id", "17")
+
+        var form deliveryForm
+        require.NoError(t, Bind(r, &form))
+        assert.Equal(t, 17, form.ID)
+        assert.Equal(t, time.Date(2019, 11, 21, 0, 0, 0, 0, time.UTC), form.From)
+        assert.Equal(t, []string{"JKT", "BDG"}, form.Areas)
+        assert.Equal(t, "nanang.jobs@gmail.com", form.Email)
+        assert.Equal(t, 17, form.CourierID)
+        assert.Equal(t, "pagi", *form.Note)
+}
+
+func TestBindErrors(t *testing.T) {
+        dto := []struct {
+                label       string
+                contentType string
+                target      string
+                body        string
+                opts        []BindOption
+                err         *AppError
+                meta        []Meta
+        }{
+                {
+                        "Test Bind Validate Fail", "application/json", "/", `{"email":"nanang.jobs@gmail","courier_id":17}`, nil,
+                        ErrBadRequest,
+                        []Meta{{Code: "email", Type: "string", Message: "Invalid Type nanang.jobs@gmail for input email"}},
+                },
+                {
+                        "Test Bind Type Fail", "application/json", "/", `{"email":"nanang.jobs@gmail.com","courier_id":"x"}`, nil,
+                        ErrBadRequest,
+                        []Meta{{Code: "courier_id", Type: "int", Message: "Invalid Type string for input courier_id"}},
+                },
+                {
+                        "Test Bind Unknown Field Fail", "application/json", "/", `{"email":"nanang.jobs@gmail.com","admin":true}`,
+                        []BindOption{DisallowUnknownFields()},
+                        ErrBadRequest,
+                        []Meta{{Code: "admin", Type: "unknown", Message: "Unknown input admin"}},
+                },
+                {
+                        "Test Bind Query Fail", "application/json", "/?from=21-11-2019", `{"email":"nanang.jobs@gmail.com","courier_id":17}`, nil,
+                        ErrBadRequest,
+                        []Meta{{Code: "from", Type: "time.Time", Message: "Invalid Type 21-11-2019 for input from"}},
+                },
+                {
+                        "Test Bind Too Large", "application/json", "/", `{"email":"nanang.jobs@gmail.com","courier_id":17}`,
+                        []BindOption{MaxBodySize(8)},
+                        ErrRequestTooLarge, nil,
+                },
+                {
+                        "Test Bind Unsupported Media", "text/plain", "/", "email=nanang.jobs@gmail.com", nil,
+                        ErrUnsupportedMedia, nil,
+                },
+        }
+        for _, tt := range dto {
+                t.Run(tt.label, func(t *testing.T) {
+                        r := httptest.NewRequest(http.MethodPost, tt.target, strings.NewReader(tt.body))
+                        r.Header.Set("Content-Type", tt.contentType)
+                        err := Bind(r, &deliveryForm{}, tt.opts...)
+                        require.Error(t, err)
+                        assert.True(t, errors.Is(err, tt.err))
+                        if tt.meta != nil {
+                                assert.Equal(t, tt.meta, AsAppError(err).Details)
+                        }
+                })
+        }
+}
+
+func TestBindForm(t *testing.T) {
+        body := url.Values{"email": {"nanang.jobs@gmail.com"}, "courier_id": {"17"}}
+        r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body.Encode()))
+        r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+        var form deliveryForm
+        require.NoError(t, Bind(r, &form))
+        assert.Equal(t, "nanang.jobs@gmail.com", form.Email)
+        assert.Equal(t, 17, form.CourierID)
+        assert.Nil(t, form.Note)
+}
+
+func TestBindMultipart(t *testing.T) {
+        buf := &bytes.Buffer{}
+        mw := multipart.NewWriter(buf)
+        _ = mw.WriteField("email", "nanang.jobs@gmail.com")
+        _ = mw.WriteField("courier_id", "17")
+        for _, name := range []string{"proof", "photo", "photo"} {
+                fw, _ := mw.CreateFormFile(name, name+".jpg")
+                _, _ = fw.Write([]byte("jpeg"))
+        }
+        _ = mw.Close()
+        r := httptest.NewRequest(http.MethodPost, "/", buf)
+        r.Header.Set("Content-Type", mw.FormDataContentType())
+
+        var form deliveryForm
+        require.NoError(t, Bind(r, &form))
+        assert.Equal(t, 17, form.CourierID)
+        require.NotNil(t, form.Proof)
+        assert.Equal(t, "proof.jpg", form.Proof.Filename)
+        assert.Len(t, form.Photos, 2)
+}
+
+func TestWriteJSONError(t *testing.T) {
+        r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"courier_id":17}`))
+        r.Header.Set("Content-Type", "application/json")
+        w := httptest.NewRecorder()
+        WriteJSON(w, r, Bind(r, &deliveryForm{}))
+
+        assert.Equal(t, StatusErrorForm, w.Code)
+        assert.Contains(t, w.Body.String(), `{"code":"email","error_type":"string","error_message":"Invalid Type  for input email"}`)
+}
+
+func TestBindTooLargeStream(t *testing.T) {
+        srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+                WriteJSON(w, r, Bind(r, &deliveryForm{}, MaxBodySize(16), ResponseWriter(w)))
+        }))
+        defer srv.Close()
+
+        // no Content-Length, the limit is hit while reading
+        body := io.MultiReader(strings.NewReader(`{"email":"nanang.jobs@gmail.com","courier_id":17}`))
+        res, err := http.Post(srv.URL, "application/json", body)
+        require.NoError(t, err)
+        defer res.Body.Close()
+        assert.Equal(t, http.StatusRequestEntityTooLarge, res.StatusCode)
+        assert.True(t, res.Close, "the server closes the connection")
+}
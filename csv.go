@@ -10,12 +10,17 @@ package suki
 
 import (
         "bytes"
+        "database/sql"
         "encoding/csv"
         "fmt"
+        "io"
         "log"
         "net/http"
+        "strings"
 )
 
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
 func WriteCSV(w http.ResponseWriter, r *http.Request, rows [][]string, filename string) {
         buf := &bytes.Buffer{}
         xCsv := csv.NewWriter(buf)
@@ -24,6 +29,7 @@ func WriteCSV(w http.ResponseWriter, r *http.Request, rows [][]string, filename
                 if err := xCsv.Write(row); err != nil {
                         log.Println("error writing record to csv:", err)
                         http.Error(w, err.Error(), http.StatusInternalServerError)
+                        return
                 }
         }
         xCsv.Flush()
@@ -31,11 +37,10 @@ func WriteCSV(w http.ResponseWriter, r *http.Request, rows [][]string, filename
         if err := xCsv.Error(); err != nil {
                 log.Println("error writing record to csv:", err)
                 http.Error(w, err.Error(), http.StatusInternalServerError)
+                return
         }
-        w.Header().Set("Content-Description", "File Transfer")
-        w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s.csv", filename))
-        w.Header().Set("Content-Type", "text/csv; charset=utf-8")
-        if status, ok := r.Context().Value(CtxResponse).(int); ok {
+        setCSVHeaders(w, filename)
+        if status, ok := ResponseStatus(w, r); ok {
                 w.WriteHeader(status)
         }
         _, err := w.Write(buf.Bytes())
@@ -44,3 +49,156 @@ func WriteCSV(w http.ResponseWriter, r *http.Request, rows [][]string, filename
                 return
         }
 }
+
+func setCSVHeaders(w http.ResponseWriter, filename string) {
+        if !strings.HasSuffix(strings.ToLower(filename), ".csv") {
+                filename += ".csv"
+        }
+        w.Header().Set("Content-Description", "File Transfer")
+        w.Header().Set("Content-Disposition", ContentDisposition(filename))
+        w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+}
+
+// ContentDisposition returns an attachment disposition for the filename,
+// quoted with an ASCII fallback and RFC 5987 encoded when it is not ASCII.
+func ContentDisposition(filename string) string {
+        ascii := strings.Map(func(r rune) rune {
+                if r < 0x20 || r > 0x7e || r == '"' || r == '\\' || r == '/' {
+                        return '_'
+                }
+                return r
+        }, filename)
+        if ascii == filename {
+                return fmt.Sprintf(`attachment; filename="%s"`, ascii)
+        }
+        return fmt.Sprintf(`attachment; filename="%s"; filename*=UTF-8''%s`, ascii, encodeExtValue(filename))
+}
+
+// encodeExtValue percent encodes the bytes that are not attr-char of RFC 5987.
+func encodeExtValue(s string) string {
+        const attrChar = "!#$&+-.^_`|~"
+        b := &strings.Builder{}
+        for i := 0; i < len(s); i++ {
+                c := s[i]
+                if ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9') || strings.IndexByte(attrChar, c) >= 0 {
+                        b.WriteByte(c)
+                        continue
+                }
+                fmt.Fprintf(b, "%%%02X", c)
+        }
+        return b.String()
+}
+
+// CSVRows returns the next csv row, or io.EOF after the last one.
+type CSVRows func() ([]string, error)
+
+// CSVFromSlice iterates over rows already in memory.
+func CSVFromSlice(rows [][]string) CSVRows {
+        i := 0
+        return func() ([]string, error) {
+                if i >= len(rows) {
+                        return nil, io.EOF
+                }
+                i++
+                return rows[i-1], nil
+        }
+}
+
+// CSVFromSQLRows iterates over a query result, the column names first, e.g.
+// inside the callback of sqlx.DB.QueryCtx. NULL values are empty strings.
+func CSVFromSQLRows(rs *sql.Rows) CSVRows {
+        var columns []string
+        return func() ([]string, error) {
+                if columns == nil {
+                        cols, err := rs.Columns()
+                        if err != nil {
+                                return nil, err
+                        }
+                        columns = cols
+                        return columns, nil
+                }
+                if !rs.Next() {
+                        if err := rs.Err(); err != nil {
+                                return nil, err
+                        }
+                        return nil, io.EOF
+                }
+                values := make([]sql.NullString, len(columns))
+                dest := make([]interface{}, len(columns))
+                for i := range values {
+                        dest[i] = &values[i]
+                }
+                if err := rs.Scan(dest...); err != nil {
+                        return nil, err
+                }
+                row := make([]string, len(columns))
+                for i, v := range values {
+                        row[i] = v.String
+                }
+                return row, nil
+        }
+}
+
+type CSVOptions struct {
+        BOM        bool // write a UTF-8 byte order mark so Excel detects the encoding
+        FlushEvery int  // rows between flushes to the client, 1000 by default
+}
+
+// StreamCSV writes the rows to the response while they are read, flushing
+// periodically instead of buffering the whole file. It stops when the client
+// disconnects. An error before the first flush is written as the error
+// envelope, after that the file is truncated and only the error is returned.
+func StreamCSV(w http.ResponseWriter, r *http.Request, filename string, next CSVRows, opts CSVOptions) error {
+        if opts.FlushEvery <= 0 {
+                opts.FlushEvery = 1000
+        }
+        out := &streamResponse{w: w, r: r, header: func(w http.ResponseWriter) {
+                setCSVHeaders(w, filename)
+        }}
+        if opts.BOM {
+                out.prefix = utf8BOM
+        }
+        xCsv := csv.NewWriter(out)
+        flusher, _ := w.(http.Flusher)
+        fail := func(err error) error {
+                if !out.started {
+                        WriteError(w, r, err)
+                }
+                return err
+        }
+        for n := 1; ; n++ {
+                if err := r.Context().Err(); err != nil {
+                        return err // client is gone, nothing to answer
+                }
+                row, err := next()
+                if err == io.EOF {
+                        break
+                }
+                if err != nil {
+                        return fail(err)
+                }
+                if err := xCsv.Write(row); err != nil {
+                        return fail(err)
+                }
+                if n%opts.FlushEvery == 0 {
+                        xCsv.Flush()
+                        if err := xCsv.Error(); err != nil {
+                                return fail(err)
+                        }
+                        if flusher != nil {
+                                flusher.Flush()
+                        }
+                }
+        }
+        xCsv.Flush()
+        if err := xCsv.Error(); err != nil {
+                return fail(err)
+        }
+        if err := out.start(); err != nil {
+                return err
+        }
+        if flusher != nil {
+                flusher.Flush()
+        }
+        return nil
+}
@@ -0,0 +1,82 @@
+/*  csv_test.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 19, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 19/10/26 17:05
+ */
+
+package suki
+
+import (
+        "context"
+        "errors"
+        "fmt"
+        "io"
+        "net/http"
+        "net/http/httptest"
+        "testing"
+
+        "github.com/stretchr/testify/assert"
+)
+
+func TestContentDisposition(t *testing.T) {
+        assert.Equal(t, `attachment; filename="report.csv"`, ContentDisposition("report.csv"))
+        assert.Equal(t, `attachment; filename="a_b_.csv"; filename*=UTF-8''a%22b%5C.csv`, ContentDisposition(`a"b\.csv`))
+        assert.Equal(t,
+                `attachment; filename="laporan _.csv"; filename*=UTF-8''laporan%20%C3%A9.csv`,
+                ContentDisposition("laporan é.csv"),
+        )
+}
+
+func TestStreamCSV(t *testing.T) {
+        rows := [][]string{
+                {"SO Number", "Area"},
+                {"SO45678", "Jakarta Selatan"},
+                {"SO45645", "Jakarta Selatan"},
+        }
+        r := httptest.NewRequest(http.MethodGet, "/csv", nil)
+        Status(r, StatusSuccess)
+        w := httptest.NewRecorder()
+        err := StreamCSV(w, r, "fleets", CSVFromSlice(rows), CSVOptions{BOM: true, FlushEvery: 1})
+
+        assert.NoError(t, err)
+        assert.True(t, w.Flushed)
+        assert.Equal(t, StatusSuccess, w.Code)
+        assert.Equal(t, `attachment; filename="fleets.csv"`, w.Header().Get("Content-Disposition"))
+        assert.Equal(t, "\xEF\xBB\xBFSO Number,Area\nSO45678,Jakarta Selatan\nSO45645,Jakarta Selatan\n", w.Body.String())
+}
+
+func TestStreamCSVError(t *testing.T) {
+        r := httptest.NewRequest(http.MethodGet, "/csv", nil)
+        w := httptest.NewRecorder()
+        err := StreamCSV(w, r, "fleets", func() ([]string, error) {
+                return nil, errors.New("connection reset")
+        }, CSVOptions{})
+
+        assert.Error(t, err)
+        assert.Equal(t, StatusInternalError, w.Code)
+        assert.Empty(t, w.Header().Get("Content-Disposition"))
+        assert.Contains(t, w.Body.String(), `"error_type":"INTERNAL_ERROR"`)
+}
+
+func TestStreamCSVClientGone(t *testing.T) {
+        ctx, cancel := context.WithCancel(context.Background())
+        r := httptest.NewRequest(http.MethodGet, "/csv", nil).WithContext(ctx)
+        w := httptest.NewRecorder()
+        n := 0
+        err := StreamCSV(w, r, "fleets", func() ([]string, error) {
+                n++
+                if n == 3 {
+                        cancel()
+                }
+                if n > 10 {
+                        return nil, io.EOF
+                }
+                return []string{fmt.Sprint(n)}, nil
+        }, CSVOptions{FlushEvery: 1})
+
+        assert.Equal(t, context.Canceled, err)
+        assert.Equal(t, 3, n)
+        assert.Equal(t, "1\n2\n3\n", w.Body.String())
+}
@@ -0,0 +1,334 @@
+/*  csvtag.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 20, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 20/10/26 09:15
+ */
+
+package suki
+
+import (
+        "database/sql"
+        "database/sql/driver"
+        "encoding/csv"
+        "fmt"
+        "io"
+        "reflect"
+        "strconv"
+        "strings"
+        "time"
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// csvField is a struct field described by its csv tag, e.g.
+// `csv:"Tanggal,format=2006-01-02"` or `csv:"Jumlah,format=%.2f"`.
+type csvField struct {
+        index  int
+        name   string
+        format string // time layout or float precision / verb
+}
+
+// csvFields returns the fields of the struct type named by their csv tag,
+// falling back to the json tag and the field name.
+func csvFields(t reflect.Type) []csvField {
+        fields := make([]csvField, 0)
+        for i := 0; i < t.NumField(); i++ {
+                f := t.Field(i)
+                if f.PkgPath != "" {
+                        continue // unexported
+                }
+                field := csvField{index: i, name: f.Name}
+                if tag, ok := f.Tag.Lookup("csv"); ok {
+                        name, format := splitTag(tag)
+                        if name == "-" {
+                                continue
+                        }
+                        if name != "" {
+                                field.name = name
+                        }
+                        field.format = format
+                } else if tag, ok := f.Tag.Lookup("json"); ok {
+                        name := strings.SplitN(tag, ",", 2)[0]
+                        if name == "-" {
+                                continue
+                        }
+                        if name != "" {
+                                field.name = name
+                        }
+                }
+                fields = append(fields, field)
+        }
+        return fields
+}
+
+// splitTag splits a tag such as "from,format=2006-01-02" into the name and
+// the format option, which is the last option since a layout may contain commas.
+func splitTag(tag string) (name, format string) {
+        name, opts := tag, ""
+        if idx := strings.Index(tag, ","); idx != -1 {
+                name, opts = tag[:idx], tag[idx+1:]
+        }
+        if idx := strings.Index(opts, "format="); idx != -1 {
+                format = opts[idx+len("format="):]
+        }
+        return name, format
+}
+
+func structType(t reflect.Type) (reflect.Type, bool) {
+        if t.Kind() == reflect.Ptr {
+                t = t.Elem()
+        }
+        return t, t.Kind() == reflect.Struct
+}
+
+// MarshalCSV converts a slice of structs to csv rows, the first row is the
+// header named by the csv tags of the fields.
+func MarshalCSV(v interface{}) ([][]string, error) {
+        if rows, ok := v.([][]string); ok {
+                return rows, nil
+        }
+        val := reflect.ValueOf(v)
+        if val.Kind() != reflect.Slice && val.Kind() != reflect.Array {
+                return nil, fmt.Errorf("csv: cannot marshal %T, want a slice of structs", v)
+        }
+        t, ok := structType(val.Type().Elem())
+        if !ok {
+                return nil, fmt.Errorf("csv: cannot marshal %T, want a slice of structs", v)
+        }
+        fields := csvFields(t)
+        header := make([]string, len(fields))
+        for i, f := range fields {
+                header[i] = f.name
+        }
+        rows := [][]string{header}
+        for i := 0; i < val.Len(); i++ {
+                elem := reflect.Indirect(val.Index(i))
+                row := make([]string, len(fields))
+                if elem.IsValid() {
+                        for j, f := range fields {
+                                row[j] = formatCSV(elem.Field(f.index), f.format)
+                        }
+                }
+                rows = append(rows, row)
+        }
+        return rows, nil
+}
+
+func formatCSV(v reflect.Value, format string) string {
+        if v.Kind() == reflect.Ptr {
+                if v.IsNil() {
+                        return ""
+                }
+                v = v.Elem()
+        }
+        value := v.Interface()
+        if valuer, ok := value.(driver.Valuer); ok {
+                dv, err := valuer.Value()
+                if err != nil || dv == nil {
+                        return ""
+                }
+                value = dv
+        }
+        switch val := value.(type) {
+        case time.Time:
+                if format == "" {
+                        format = time.RFC3339
+                }
+                return val.Format(format)
+        case float64:
+                return formatFloat(val, format, 64)
+        case float32:
+                return formatFloat(float64(val), format, 32)
+        case fmt.Stringer:
+                return val.String()
+        }
+        if format != "" {
+                return fmt.Sprintf(format, value)
+        }
+        return fmt.Sprint(value)
+}
+
+// formatFloat formats with a printf verb such as %.2f or a precision such as 2.
+func formatFloat(f float64, format string, bitSize int) string {
+        if strings.HasPrefix(format, "%") {
+                return fmt.Sprintf(format, f)
+        }
+        prec, err := strconv.Atoi(format)
+        if err != nil {
+                prec = -1
+        }
+        return strconv.FormatFloat(f, 'f', prec, bitSize)
+}
+
+// UnmarshalCSV decodes a csv with a header row into dst, a pointer to a slice
+// of structs, matching columns to the csv tags of the fields. Every row is
+// appended to dst; values that cannot be converted, and validation errors
+// when validate is set, are returned as meta entries naming the row and column.
+// The error is only set when the csv cannot be read at all.
+func UnmarshalCSV(r io.Reader, dst interface{}, validate bool) ([]Meta, error) {
+        ptr := reflect.ValueOf(dst)
+        if ptr.Kind() != reflect.Ptr || ptr.Elem().Kind() != reflect.Slice {
+                return nil, fmt.Errorf("csv: cannot unmarshal into %T, want a pointer to a slice of structs", dst)
+        }
+        slice := ptr.Elem()
+        elemType := slice.Type().Elem()
+        t, ok := structType(elemType)
+        if !ok {
+                return nil, fmt.Errorf("csv: cannot unmarshal into %T, want a pointer to a slice of structs", dst)
+        }
+        reader := csv.NewReader(r)
+        reader.FieldsPerRecord = -1
+        header, err := reader.Read()
+        if err != nil {
+                return nil, err
+        }
+        byName := make(map[string]csvField)
+        for _, f := range csvFields(t) {
+                byName[strings.ToLower(f.name)] = f
+        }
+        header[0] = strings.TrimPrefix(header[0], string(utf8BOM))
+        columns := make([]*csvField, len(header))
+        for i, name := range header {
+                if f, ok := byName[strings.ToLower(strings.TrimSpace(name))]; ok {
+                        columns[i] = &f
+                }
+        }
+        errs := make([]Meta, 0)
+        for row := 2; ; row++ {
+                record, err := reader.Read()
+                if err == io.EOF {
+                        break
+                }
+                if err != nil {
+                        return errs, err
+                }
+                elem := reflect.New(t).Elem()
+                for i, value := range record {
+                        if i >= len(columns) || columns[i] == nil {
+                                continue
+                        }
+                        field := elem.Field(columns[i].index)
+                        if err := parseValue(field, value, columns[i].format); err != nil {
+                                errs = append(errs, Meta{
+                                        Code:    header[i],
+                                        Type:    field.Type().String(),
+                                        Message: fmt.Sprintf("Invalid Type %v for input %s at row %d", value, header[i], row),
+                                })
+                        }
+                }
+                if validate {
+                        for _, m := range Validate(elem.Interface()) {
+                                m.Message = fmt.Sprintf("%s at row %d", m.Message, row)
+                                errs = append(errs, m)
+                        }
+                }
+                if elemType.Kind() == reflect.Ptr {
+                        elem = elem.Addr()
+                }
+                slice.Set(reflect.Append(slice, elem))
+        }
+        if len(errs) == 0 {
+                return nil, nil
+        }
+        return errs, nil
+}
+
+// parseValue sets v from its text form, format is the layout of a time.
+func parseValue(v reflect.Value, s, format string) error {
+        if v.Kind() == reflect.Ptr {
+                if s == "" {
+                        return nil
+                }
+                v.Set(reflect.New(v.Type().Elem()))
+                v = v.Elem()
+        }
+        if scanner, ok := v.Addr().Interface().(sql.Scanner); ok {
+                return scanValue(scanner, s, format)
+        }
+        if v.Type() == timeType {
+                if s == "" {
+                        return nil
+                }
+                t, err := parseTime(s, format)
+                if err == nil {
+                        v.Set(reflect.ValueOf(t))
+                }
+                return err
+        }
+        s = strings.TrimSpace(s)
+        switch v.Kind() {
+        case reflect.String:
+                v.SetString(s)
+        case reflect.Bool:
+                if s == "" {
+                        return nil
+                }
+                b, err := strconv.ParseBool(s)
+                if err != nil {
+                        return err
+                }
+                v.SetBool(b)
+        case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+                if s == "" {
+                        return nil
+                }
+                n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+                if err != nil {
+                        return err
+                }
+                v.SetInt(n)
+        case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+                if s == "" {
+                        return nil
+                }
+                n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+                if err != nil {
+                        return err
+                }
+                v.SetUint(n)
+        case reflect.Float32, reflect.Float64:
+                if s == "" {
+                        return nil
+                }
+                f, err := strconv.ParseFloat(s, v.Type().Bits())
+                if err != nil {
+                        return err
+                }
+                v.SetFloat(f)
+        default:
+                return fmt.Errorf("csv: unsupported type %s", v.Type())
+        }
+        return nil
+}
+
+func parseTime(s, layout string) (time.Time, error) {
+        if layout == "" {
+                layout = time.RFC3339
+        }
+        return time.Parse(layout, strings.TrimSpace(s))
+}
+
+// scanValue fills a sql.Scanner such as sqlx.NullString, an empty value is NULL.
+// Scanners that stay NULL for a string, such as sqlx.NullTime, get a time.
+func scanValue(scanner sql.Scanner, s, format string) error {
+        if s == "" {
+                return scanner.Scan(nil)
+        }
+        if err := scanner.Scan(s); err != nil {
+                return err
+        }
+        valuer, ok := scanner.(driver.Valuer)
+        if !ok {
+                return nil
+        }
+        if dv, err := valuer.Value(); err != nil || dv != nil {
+                return err
+        }
+        t, err := parseTime(s, format)
+        if err != nil {
+                return err
+        }
+        return scanner.Scan(t)
+}
@@ -0,0 +1,78 @@
+/*  csvtag_test.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 20, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 20/10/26 10:02
+ */
+
+package suki
+
+import (
+        "strings"
+        "testing"
+        "time"
+
+        "github.com/stretchr/testify/assert"
+        "github.com/stretchr/testify/require"
+)
+
+type invoice struct {
+        Number  string    `csv:"No Invoice" validate:"required"`
+        Date    time.Time `csv:"Tanggal,format=02 Jan 2006"`
+        Amount  float64   `csv:"Jumlah,format=%.2f"`
+        Rate    float64   `csv:"Kurs,format=1"`
+        Items   int       `json:"items" validate:"gt=0"`
+        Note    *string   `csv:"Catatan"`
+        Ignored string    `csv:"-"`
+}
+
+func TestMarshalCSV(t *testing.T) {
+        note := "lunas"
+        rows, err := MarshalCSV([]invoice{
+                {Number: "INV-1", Date: time.Date(2019, 11, 21, 0, 0, 0, 0, time.UTC), Amount: 1500.5, Rate: 14000.25, Items: 2, Note: &note},
+                {Number: "INV-2", Date: time.Date(2019, 11, 22, 0, 0, 0, 0, time.UTC), Amount: 20, Rate: 1, Items: 1},
+        })
+        require.NoError(t, err)
+        assert.Equal(t, [][]string{
+                {"No Invoice", "Tanggal", "Jumlah", "Kurs", "items", "Catatan"},
+                {"INV-1", "21 Nov 2019", "1500.50", "14000.2", "2", "lunas"},
+                {"INV-2", "22 Nov 2019", "20.00", "1.0", "1", ""},
+        }, rows)
+
+        _, err = MarshalCSV([]int{1, 2})
+        assert.Error(t, err)
+}
+
+func TestUnmarshalCSV(t *testing.T) {
+        in := "\xEF\xBB\xBFNo Invoice,tanggal,Jumlah,items,Catatan,Unknown\n" +
+                "INV-1,21 Nov 2019,1500.50,2,lunas,x\n" +
+                "INV-2,2019-11-22,abc,1,,x\n" +
+                ",22 Nov 2019,10,0,,x\n"
+        invoices := make([]*invoice, 0)
+        errs, err := UnmarshalCSV(strings.NewReader(in), &invoices, true)
+        require.NoError(t, err)
+        require.Len(t, invoices, 3)
+
+        assert.Equal(t, "INV-1", invoices[0].Number)
+        assert.Equal(t, time.Date(2019, 11, 21, 0, 0, 0, 0, time.UTC), invoices[0].Date)
+        assert.Equal(t, 1500.5, invoices[0].Amount)
+        assert.Equal(t, "lunas", *invoices[0].Note)
+        assert.Nil(t, invoices[1].Note)
+
+        assert.Equal(t, []Meta{
+                {Code: "tanggal", Type: "time.Time", Message: "Invalid Type 2019-11-22 for input tanggal at row 3"},
+                {Code: "Jumlah", Type: "float64", Message: "Invalid Type abc for input Jumlah at row 3"},
+                {Code: "Number", Type: "string", Message: "Invalid Type  for input Number at row 4"},
+                {Code: "items", Type: "int", Message: "Invalid Type 0 for input items at row 4"},
+        }, errs)
+}
+
+func TestUnmarshalCSVInvalid(t *testing.T) {
+        var dst []invoice
+        _, err := UnmarshalCSV(strings.NewReader("a\n1\n"), dst, false)
+        assert.Error(t, err, "dst must be a pointer")
+
+        _, err = UnmarshalCSV(strings.NewReader(""), &dst, false)
+        assert.Error(t, err, "missing header")
+}
@@ -0,0 +1,130 @@
+/*  devencoder.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 21, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 24/10/26 12:50
+ */
+
+package suki
+
+import (
+        "bytes"
+        "encoding/json"
+        "os"
+        "strings"
+
+        "go.uber.org/zap/buffer"
+        "go.uber.org/zap/zapcore"
+)
+
+// EnvLogEncoding selects the encoding of the process wide logger when
+// Options.Encoding is empty, e.g. SUKI_LOG_ENCODING=development.
+const EnvLogEncoding = "SUKI_LOG_ENCODING"
+
+// EncodingDevelopment is the Options.Encoding of NewDevelopmentEncoder.
+const EncodingDevelopment = "development"
+
+var devPool = buffer.NewPool()
+
+// NewZapDevelopmentEncoderConfig is the config of the fields written by
+// NewDevelopmentEncoder, local ISO-8601 times and readable durations.
+func NewZapDevelopmentEncoderConfig() zapcore.EncoderConfig {
+        return zapcore.EncoderConfig{
+                LineEnding:     zapcore.DefaultLineEnding,
+                EncodeTime:     zapcore.ISO8601TimeEncoder,
+                EncodeDuration: zapcore.StringDurationEncoder,
+        }
+}
+
+// NewDevelopmentEncoder returns a console encoder to read the logs locally,
+//
+//	2026-10-21T17:30:00.000+0700 INFO  received work order
+//	    {
+//	      "order": "INV-7"
+//	    }
+//
+// the level is coloured unless NO_COLOR is set and the stack trace of an
+// entry follows it line by line.
+func NewDevelopmentEncoder() zapcore.Encoder {
+        _, noColor := os.LookupEnv("NO_COLOR")
+        return &devEncoder{
+                Encoder: zapcore.NewJSONEncoder(NewZapDevelopmentEncoderConfig()),
+                color:   !noColor,
+        }
+}
+
+// devEncoder writes the header of the entry itself and its fields,
+// with those added by With, as indented JSON.
+type devEncoder struct {
+        zapcore.Encoder
+        color bool
+}
+
+func (e *devEncoder) Clone() zapcore.Encoder {
+        return &devEncoder{Encoder: e.Encoder.Clone(), color: e.color}
+}
+
+var levelColors = map[zapcore.Level]int{
+        zapcore.DebugLevel:  35, // magenta
+        zapcore.InfoLevel:   34, // blue
+        zapcore.WarnLevel:   33, // yellow
+        zapcore.ErrorLevel:  31, // red
+        zapcore.DPanicLevel: 31,
+        zapcore.PanicLevel:  31,
+        zapcore.FatalLevel:  31,
+}
+
+func (e *devEncoder) EncodeEntry(ent zapcore.Entry, fields []ZapField) (*buffer.Buffer, error) {
+        line := devPool.Get()
+        line.AppendString(ent.Time.Local().Format("2006-01-02T15:04:05.000Z0700"))
+        line.AppendByte(' ')
+        name := ent.Level.CapitalString()
+        if e.color {
+                line.AppendString("\x1b[")
+                line.AppendInt(int64(levelColors[ent.Level]))
+                line.AppendByte('m')
+                line.AppendString(name)
+                line.AppendString("\x1b[0m")
+        } else {
+                line.AppendString(name)
+        }
+        // a custom level, such as LEVEL(-5), is longer than the column
+        pad := 6 - len(name)
+        if pad < 1 {
+                pad = 1
+        }
+        line.AppendString(strings.Repeat(" ", pad))
+        if ent.LoggerName != "" {
+                line.AppendString(ent.LoggerName)
+                line.AppendString(": ")
+        }
+        line.AppendString(ent.Message)
+        line.AppendByte('\n')
+
+        // the JSON encoder writes the fields only, its config has no entry keys
+        fieldsBuf, err := e.Encoder.EncodeEntry(zapcore.Entry{}, fields)
+        if err != nil {
+                line.Free()
+                return nil, err
+        }
+        defer fieldsBuf.Free()
+        if raw := bytes.TrimSpace(fieldsBuf.Bytes()); len(raw) > 2 {
+                var pretty bytes.Buffer
+                if err := json.Indent(&pretty, raw, "    ", "  "); err != nil {
+                        pretty.Reset()
+                        pretty.Write(raw)
+                }
+                line.AppendString("    ")
+                _, _ = line.Write(pretty.Bytes())
+                line.AppendByte('\n')
+        }
+        if ent.Stack != "" {
+                for _, frame := range strings.Split(ent.Stack, "\n") {
+                        line.AppendString("    ")
+                        line.AppendString(frame)
+                        line.AppendByte('\n')
+                }
+        }
+        return line, nil
+}
@@ -0,0 +1,71 @@
+/*  devencoder_test.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 21, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 24/10/26 12:50
+ */
+
+package suki
+
+import (
+        "bytes"
+        "errors"
+        "os"
+        "strings"
+        "testing"
+        "time"
+
+        "github.com/stretchr/testify/assert"
+        "go.uber.org/zap/zapcore"
+)
+
+func TestDevelopmentEncoder(t *testing.T) {
+        enc := &devEncoder{Encoder: zapcore.NewJSONEncoder(NewZapDevelopmentEncoderConfig())}
+        enc.AddString("request_id", "a1b2")
+        at := time.Date(2026, 10, 21, 17, 30, 0, 0, time.Local)
+        buf, err := enc.EncodeEntry(zapcore.Entry{
+                Level:   zapcore.WarnLevel,
+                Time:    at,
+                Message: "work may fail",
+                Stack:   "main.work\n\t/src/main.go:12",
+        }, []ZapField{Duration("took", 1500*time.Millisecond), Err(errors.New("late"))})
+        assert.NoError(t, err)
+        assert.Equal(t, at.Format("2006-01-02T15:04:05.000Z0700")+` WARN  work may fail
+    {
+      "request_id": "a1b2",
+      "took": "1.5s",
+      "error": "late"
+    }
+    main.work
+`+"    \t/src/main.go:12\n", buf.String())
+
+        buf, err = enc.EncodeEntry(zapcore.Entry{Level: zapcore.InfoLevel, Time: at, Message: "received work order"}, nil)
+        assert.NoError(t, err)
+        assert.True(t, strings.HasSuffix(buf.String(), " INFO  received work order\n    {\n      \"request_id\": \"a1b2\"\n    }\n"))
+
+        buf, err = enc.EncodeEntry(zapcore.Entry{Level: zapcore.Level(-5), Time: at, Message: "tracing work"}, nil)
+        assert.NoError(t, err)
+        assert.Contains(t, buf.String(), " LEVEL(-5) tracing work\n")
+
+        enc.color = true
+        buf, err = enc.EncodeEntry(zapcore.Entry{Level: zapcore.ErrorLevel, Time: at, Message: "work failed"}, nil)
+        assert.NoError(t, err)
+        assert.Contains(t, buf.String(), "\x1b[31mERROR\x1b[0m work failed")
+}
+
+func TestDevelopmentFromEnv(t *testing.T) {
+        old := os.Getenv(EnvLogEncoding)
+        defer os.Setenv(EnvLogEncoding, old)
+        os.Setenv(EnvLogEncoding, EncodingDevelopment)
+
+        var buf bytes.Buffer
+        l := newZapLog(Options{Outputs: []zapcore.WriteSyncer{zapcore.AddSync(&buf)}, DisableCaller: true})
+        l.Error("work failed", String("order", "INV-7"))
+        lines := strings.Split(buf.String(), "\n")
+        assert.Contains(t, lines[0], "work failed")
+        assert.Equal(t, `      "order": "INV-7"`, lines[2])
+        assert.Contains(t, buf.String(), "TestDevelopmentFromEnv", "the stack trace of an error")
+
+        assert.Equal(t, "json", logEncoding(Options{Encoding: "json"}))
+}
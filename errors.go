@@ -0,0 +1,236 @@
+/*  errors.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 19, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 24/10/26 11:45
+ */
+
+package suki
+
+import (
+        "context"
+        "errors"
+        "fmt"
+        "net/http"
+
+        "google.golang.org/grpc"
+        "google.golang.org/grpc/codes"
+        "google.golang.org/grpc/status"
+)
+
+// AppError is an application error mapped to a http status. Message is
+// safe to show to the client, Cause is only written to the log.
+type AppError struct {
+        Code    string // application error code, rendered as the meta error_type
+        Status  int
+        Message string
+        Cause   error
+        Details []Meta
+}
+
+var (
+        ErrBadRequest          = NewError(StatusErrorForm, "BAD_REQUEST", StatusText(StatusErrorForm))
+        ErrUnauthorized        = NewError(StatusUnauthorized, "UNAUTHORIZED", StatusText(StatusUnauthorized))
+        ErrForbidden           = NewError(StatusForbidden, "FORBIDDEN", StatusText(StatusForbidden))
+        ErrNotFound            = NewError(StatusNotFound, "NOT_FOUND", StatusText(StatusNotFound))
+        ErrMethodNotAllowed    = NewError(StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", StatusText(StatusMethodNotAllowed))
+        ErrNotAcceptable       = NewError(StatusNotAcceptable, "NOT_ACCEPTABLE", StatusText(StatusNotAcceptable))
+        ErrConflict            = NewError(StatusConflict, "CONFLICT", StatusText(StatusConflict))
+        ErrRequestTooLarge     = NewError(StatusRequestTooLarge, "REQUEST_TOO_LARGE", StatusText(StatusRequestTooLarge))
+        ErrUnsupportedMedia    = NewError(StatusUnsupportedMedia, "UNSUPPORTED_MEDIA_TYPE", StatusText(StatusUnsupportedMedia))
+        ErrUnprocessableEntity = NewError(StatusUnprocessableEntity, "UNPROCESSABLE_ENTITY", StatusText(StatusUnprocessableEntity))
+        ErrTooManyRequests     = NewError(StatusTooManyRequests, "TOO_MANY_REQUESTS", StatusText(StatusTooManyRequests))
+        ErrInternal            = NewError(StatusInternalError, "INTERNAL_ERROR", StatusText(StatusInternalError))
+        ErrUnavailable         = NewError(StatusServiceUnavailable, "SERVICE_UNAVAILABLE", StatusText(StatusServiceUnavailable))
+)
+
+// NewError creates an AppError, usually declared once as a package variable
+// and returned with Wrap or WithMessage by the handlers.
+func NewError(status int, code, message string) *AppError {
+        return &AppError{
+                Code:    code,
+                Status:  status,
+                Message: message,
+        }
+}
+
+func (e *AppError) Error() string {
+        if e.Cause != nil {
+                return fmt.Sprintf("%s: %s: %v", e.Code, e.Message, e.Cause)
+        }
+        return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// Unwrap returns the internal cause of the error.
+func (e *AppError) Unwrap() error {
+        return e.Cause
+}
+
+// Is reports whether target is an AppError with the same code,
+// so errors.Is(err, ErrNotFound) holds for any wrapped copy of it.
+func (e *AppError) Is(target error) bool {
+        t, ok := target.(*AppError)
+        return ok && t.Code == e.Code
+}
+
+// Wrap returns a copy of the error caused by err.
+func (e *AppError) Wrap(err error) *AppError {
+        c := *e
+        c.Cause = err
+        return &c
+}
+
+// WithMessage returns a copy of the error with another public message.
+func (e *AppError) WithMessage(message string) *AppError {
+        c := *e
+        c.Message = message
+        return &c
+}
+
+// WithDetails returns a copy of the error with details appended to the meta.
+func (e *AppError) WithDetails(details ...Meta) *AppError {
+        c := *e
+        c.Details = append(append([]Meta(nil), e.Details...), details...)
+        return &c
+}
+
+// Localize returns a copy of the error with the message of its code, or
+// of its status, in the locale. A message set by WithMessage is kept unless
+// it is the one of the default locale.
+func (e *AppError) Localize(locale string) *AppError {
+        c := *e
+        key := "error." + e.Code
+        if msg, ok := lookup(locale, key); ok {
+                if def, _ := lookup(DefaultLocale, key); def == e.Message {
+                        c.Message = msg
+                }
+                return &c
+        }
+        if e.Message == StatusText(e.Status) {
+                c.Message = StatusTextLocale(locale, e.Status)
+        }
+        return &c
+}
+
+// Meta returns the meta entries rendered in the response envelope.
+func (e *AppError) Meta() []Meta {
+        return append([]Meta{{
+                Code:    StatusCode(e.Status),
+                Type:    e.Code,
+                Message: e.Message,
+        }}, e.Details...)
+}
+
+// GRPCStatus returns the gRPC status of the error, it is used
+// by status.FromError and status.Code.
+func (e *AppError) GRPCStatus() *status.Status {
+        return status.New(GRPCCode(e.Status), e.Message)
+}
+
+// AsAppError returns the AppError in the chain of err, any other error
+// is returned as an internal error caused by err.
+func AsAppError(err error) *AppError {
+        var e *AppError
+        if errors.As(err, &e) {
+                return e
+        }
+        return ErrInternal.Wrap(err)
+}
+
+// RequestError returns the AppError of err in the locale of the request,
+// an internal error is logged with the method and the path of the request.
+func RequestError(r *http.Request, err error) *AppError {
+        e := AsAppError(err)
+        if e.Status >= StatusInternalError {
+                Error(e.Message,
+                        Field("code", e.Code),
+                        Field("method", r.Method),
+                        Field("path", r.URL.Path),
+                        Field("error", err),
+                )
+        }
+        return e.Localize(Locale(r.Context()))
+}
+
+// WriteError writes err as the response envelope with the status of the error.
+func WriteError(w http.ResponseWriter, r *http.Request, err error) {
+        e := RequestError(r, err)
+        if WantsProblem(r) {
+                WriteProblem(w, r, ProblemFromError(r, err))
+                return
+        }
+        setStatus(w, r, e.Status)
+        res := Response()
+        res.Errors(e.Meta()...)
+        WriteJSON(w, r, res)
+}
+
+// GRPCCode maps a http status to the gRPC status code.
+func GRPCCode(status int) codes.Code {
+        switch status {
+        case http.StatusOK, http.StatusCreated, http.StatusAccepted, http.StatusNoContent:
+                return codes.OK
+        case http.StatusBadRequest, http.StatusUnprocessableEntity, http.StatusUnsupportedMediaType:
+                return codes.InvalidArgument
+        case http.StatusUnauthorized, http.StatusProxyAuthRequired:
+                return codes.Unauthenticated
+        case http.StatusForbidden:
+                return codes.PermissionDenied
+        case http.StatusNotFound:
+                return codes.NotFound
+        case http.StatusMethodNotAllowed, http.StatusNotImplemented:
+                return codes.Unimplemented
+        case http.StatusConflict:
+                return codes.AlreadyExists
+        case http.StatusPreconditionFailed:
+                return codes.FailedPrecondition
+        case http.StatusRequestEntityTooLarge, http.StatusTooManyRequests:
+                return codes.ResourceExhausted
+        case http.StatusRequestTimeout, http.StatusGatewayTimeout:
+                return codes.DeadlineExceeded
+        case http.StatusServiceUnavailable, http.StatusBadGateway:
+                return codes.Unavailable
+        case 499: // client closed request
+                return codes.Canceled
+        }
+        return codes.Internal
+}
+
+// grpcError converts err returned by a gRPC handler to a status error.
+func grpcError(err error) error {
+        var e *AppError
+        if errors.As(err, &e) {
+                if e.Status >= StatusInternalError {
+                        Error(e.Message, Field("code", e.Code), Field("error", err))
+                }
+                return e.GRPCStatus().Err()
+        }
+        if _, ok := status.FromError(err); ok {
+                return err
+        }
+        Error(err.Error(), Field("error", err))
+        return status.Error(codes.Internal, StatusText(StatusInternalError))
+}
+
+// UnaryServerInterceptor maps errors returned by unary handlers to gRPC
+// status errors, hiding the message of errors that are not an AppError.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+        return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+                resp, err := handler(ctx, req)
+                if err != nil {
+                        return resp, grpcError(err)
+                }
+                return resp, nil
+        }
+}
+
+// StreamServerInterceptor is the streaming counterpart of UnaryServerInterceptor.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+        return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+                if err := handler(srv, ss); err != nil {
+                        return grpcError(err)
+                }
+                return nil
+        }
+}
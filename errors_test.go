@@ -0,0 +1,108 @@
+/*  errors_test.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 19, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 19/10/26 13:40
+ */
+
+package suki
+
+import (
+        "context"
+        "database/sql"
+        "encoding/json"
+        "errors"
+        "fmt"
+        "net/http"
+        "net/http/httptest"
+        "testing"
+
+        "github.com/stretchr/testify/assert"
+        "github.com/stretchr/testify/require"
+        "google.golang.org/grpc/codes"
+        "google.golang.org/grpc/status"
+)
+
+func TestAppErrorChain(t *testing.T) {
+        err := fmt.Errorf("find user: %w", ErrNotFound.Wrap(sql.ErrNoRows))
+
+        assert.True(t, errors.Is(err, ErrNotFound))
+        assert.True(t, errors.Is(err, sql.ErrNoRows))
+        assert.False(t, errors.Is(err, ErrConflict))
+
+        var e *AppError
+        require.True(t, errors.As(err, &e))
+        assert.Equal(t, StatusNotFound, e.Status)
+        assert.Nil(t, ErrNotFound.Cause, "Wrap must not modify the declared error")
+}
+
+func TestWriteError(t *testing.T) {
+        tests := []struct {
+                name   string
+                err    error
+                status int
+                meta   []Meta
+        }{
+                {
+                        name:   "Conflict",
+                        err:    ErrConflict.WithMessage("email already registered"),
+                        status: StatusConflict,
+                        meta:   []Meta{{Code: "STATUS_CONFLICT", Type: "CONFLICT", Message: "email already registered"}},
+                },
+                {
+                        name:   "Details",
+                        err:    ErrBadRequest.WithDetails(Meta{Code: "email", Type: "string", Message: "Invalid Type x for input email"}),
+                        status: StatusErrorForm,
+                        meta: []Meta{
+                                {Code: "STATUS_BAD_REQUEST", Type: "BAD_REQUEST", Message: "Invalid data request"},
+                                {Code: "email", Type: "string", Message: "Invalid Type x for input email"},
+                        },
+                },
+                {
+                        name:   "Unknown error is hidden",
+                        err:    errors.New("pq: connection refused"),
+                        status: StatusInternalError,
+                        meta:   []Meta{{Code: "INTERNAL_SERVER_ERROR", Type: "INTERNAL_ERROR", Message: "Oops something went wrong"}},
+                },
+        }
+        for _, tt := range tests {
+                t.Run(tt.name, func(t *testing.T) {
+                        r := httptest.NewRequest(http.MethodGet, "/", nil)
+                        w := httptest.NewRecorder()
+                        WriteError(w, r, tt.err)
+
+                        assert.Equal(t, tt.status, w.Code)
+                        var body struct {
+                                Meta []Meta `json:"meta"`
+                        }
+                        require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+                        assert.Equal(t, tt.meta, body.Meta)
+                })
+        }
+}
+
+func TestStatusFallback(t *testing.T) {
+        assert.Equal(t, "STATUS_NOT_FOUND", StatusCode(http.StatusNotFound))
+        assert.Equal(t, "STATUS_IM_A_TEAPOT", StatusCode(http.StatusTeapot))
+        assert.Equal(t, "I'm a teapot", StatusText(http.StatusTeapot))
+        assert.Equal(t, "STATUS_UNKNOWN", StatusCode(999))
+        assert.Equal(t, "Oops something went wrong", StatusText(999))
+}
+
+func TestGRPCStatus(t *testing.T) {
+        assert.Equal(t, codes.NotFound, status.Code(ErrNotFound))
+        assert.Equal(t, codes.AlreadyExists, GRPCCode(StatusConflict))
+
+        interceptor := UnaryServerInterceptor()
+        _, err := interceptor(context.Background(), nil, nil, func(ctx context.Context, req interface{}) (interface{}, error) {
+                return nil, fmt.Errorf("wrapped: %w", ErrForbidden)
+        })
+        assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+        _, err = interceptor(context.Background(), nil, nil, func(ctx context.Context, req interface{}) (interface{}, error) {
+                return nil, errors.New("secret internal detail")
+        })
+        assert.Equal(t, codes.Internal, status.Code(err))
+        assert.NotContains(t, err.Error(), "secret")
+}
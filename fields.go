@@ -0,0 +1,129 @@
+/*  fields.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 21, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 21/10/26 15:20
+ */
+
+package suki
+
+import (
+        "fmt"
+        "time"
+
+        "go.uber.org/zap"
+        "go.uber.org/zap/zapcore"
+)
+
+// The typed fields skip the type switch of Field, e.g.
+//
+//	suki.Info("order delivered", suki.String("order", id), suki.Duration("took", d))
+//
+// Field(key, value) keeps working for any value. A Logging method still
+// boxes each field into an interface{}, the methods of FieldLogging take
+// them as they are.
+
+// FieldLogging logs the typed fields without boxing them, e.g.
+//
+//	suki.Fields(suki.FromContext(ctx)).InfoFields("order delivered", suki.String("order", id))
+type FieldLogging interface {
+        DebugFields(msg string, fields ...ZapField)
+        InfoFields(msg string, fields ...ZapField)
+        WarnFields(msg string, fields ...ZapField)
+        ErrorFields(msg string, fields ...ZapField)
+        FatalFields(msg string, fields ...ZapField)
+        PanicFields(msg string, fields ...ZapField)
+}
+
+// Fields returns the FieldLogging of l, the suki loggers implement it,
+// any other Logging gets the fields through its own methods.
+func Fields(l Logging) FieldLogging {
+        if fl, ok := l.(FieldLogging); ok {
+                return fl
+        }
+        return boxedFields{l}
+}
+
+// boxedFields is the FieldLogging of a Logging which has none.
+type boxedFields struct {
+        logger Logging
+}
+
+func (b boxedFields) DebugFields(msg string, fields ...ZapField) {
+        logSkip(b.logger, zapcore.DebugLevel, 1, msg, []interface{}{fields})
+}
+
+func (b boxedFields) InfoFields(msg string, fields ...ZapField) {
+        logSkip(b.logger, zapcore.InfoLevel, 1, msg, []interface{}{fields})
+}
+
+func (b boxedFields) WarnFields(msg string, fields ...ZapField) {
+        logSkip(b.logger, zapcore.WarnLevel, 1, msg, []interface{}{fields})
+}
+
+func (b boxedFields) ErrorFields(msg string, fields ...ZapField) {
+        logSkip(b.logger, zapcore.ErrorLevel, 1, msg, []interface{}{fields})
+}
+
+func (b boxedFields) FatalFields(msg string, fields ...ZapField) {
+        logSkip(b.logger, zapcore.FatalLevel, 1, msg, []interface{}{fields})
+}
+
+func (b boxedFields) PanicFields(msg string, fields ...ZapField) {
+        logSkip(b.logger, zapcore.PanicLevel, 1, msg, []interface{}{fields})
+}
+
+func String(key, val string) ZapField {
+        return zap.String(key, val)
+}
+
+func Strings(key string, val []string) ZapField {
+        return zap.Strings(key, val)
+}
+
+func Int(key string, val int) ZapField {
+        return zap.Int(key, val)
+}
+
+func Int64(key string, val int64) ZapField {
+        return zap.Int64(key, val)
+}
+
+func Uint64(key string, val uint64) ZapField {
+        return zap.Uint64(key, val)
+}
+
+func Float64(key string, val float64) ZapField {
+        return zap.Float64(key, val)
+}
+
+func Bool(key string, val bool) ZapField {
+        return zap.Bool(key, val)
+}
+
+func Duration(key string, val time.Duration) ZapField {
+        return zap.Duration(key, val)
+}
+
+func Time(key string, val time.Time) ZapField {
+        return zap.Time(key, val)
+}
+
+func Stringer(key string, val fmt.Stringer) ZapField {
+        return zap.Stringer(key, val)
+}
+
+func Binary(key string, val []byte) ZapField {
+        return zap.Binary(key, val)
+}
+
+// Err returns the error field under the "error" key, a nil error is skipped.
+func Err(err error) ZapField {
+        return zap.Error(err)
+}
+
+// NamedErr returns the error field under the key, a nil error is skipped.
+func NamedErr(key string, err error) ZapField {
+        return zap.NamedError(key, err)
+}
@@ -0,0 +1,98 @@
+/*  grpclog.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 21, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 23/10/26 09:15
+ */
+
+package suki
+
+import (
+        "fmt"
+
+        "go.uber.org/zap/zapcore"
+        "google.golang.org/grpc/grpclog"
+)
+
+// grpcSkip counts the frames of the grpcLogger method and the grpclog
+// function, e.g. grpclog.Infof, the caller is the line calling it.
+const grpcSkip = 2
+
+// grpcLogger is a grpclog.LoggerV2 logging with a suki logger.
+type grpcLogger struct {
+        logger    Logging
+        verbosity int
+}
+
+// NewGRPCLogger returns a grpclog.LoggerV2 writing to l, nil for the
+// process wide logger, V reports true up to verbosity.
+func NewGRPCLogger(l Logging, verbosity int) grpclog.LoggerV2 {
+        if l == nil {
+                l = Current()
+        }
+        return &grpcLogger{logger: l.With(String("system", "grpc")), verbosity: verbosity}
+}
+
+// SetGRPCLogger makes gRPC log with l, see NewGRPCLogger.
+func SetGRPCLogger(l Logging, verbosity int) {
+        grpclog.SetLoggerV2(NewGRPCLogger(l, verbosity))
+}
+
+func (g *grpcLogger) Info(args ...interface{}) {
+        logSkip(g.logger, zapcore.InfoLevel, grpcSkip, fmt.Sprint(args...), nil)
+}
+
+func (g *grpcLogger) Infoln(args ...interface{}) {
+        logSkip(g.logger, zapcore.InfoLevel, grpcSkip, sprintln(args...), nil)
+}
+
+func (g *grpcLogger) Infof(format string, args ...interface{}) {
+        logSkip(g.logger, zapcore.InfoLevel, grpcSkip, fmt.Sprintf(format, args...), nil)
+}
+
+func (g *grpcLogger) Warning(args ...interface{}) {
+        logSkip(g.logger, zapcore.WarnLevel, grpcSkip, fmt.Sprint(args...), nil)
+}
+
+func (g *grpcLogger) Warningln(args ...interface{}) {
+        logSkip(g.logger, zapcore.WarnLevel, grpcSkip, sprintln(args...), nil)
+}
+
+func (g *grpcLogger) Warningf(format string, args ...interface{}) {
+        logSkip(g.logger, zapcore.WarnLevel, grpcSkip, fmt.Sprintf(format, args...), nil)
+}
+
+func (g *grpcLogger) Error(args ...interface{}) {
+        logSkip(g.logger, zapcore.ErrorLevel, grpcSkip, fmt.Sprint(args...), nil)
+}
+
+func (g *grpcLogger) Errorln(args ...interface{}) {
+        logSkip(g.logger, zapcore.ErrorLevel, grpcSkip, sprintln(args...), nil)
+}
+
+func (g *grpcLogger) Errorf(format string, args ...interface{}) {
+        logSkip(g.logger, zapcore.ErrorLevel, grpcSkip, fmt.Sprintf(format, args...), nil)
+}
+
+func (g *grpcLogger) Fatal(args ...interface{}) {
+        logSkip(g.logger, zapcore.FatalLevel, grpcSkip, fmt.Sprint(args...), nil)
+}
+
+func (g *grpcLogger) Fatalln(args ...interface{}) {
+        logSkip(g.logger, zapcore.FatalLevel, grpcSkip, sprintln(args...), nil)
+}
+
+func (g *grpcLogger) Fatalf(format string, args ...interface{}) {
+        logSkip(g.logger, zapcore.FatalLevel, grpcSkip, fmt.Sprintf(format, args...), nil)
+}
+
+func (g *grpcLogger) V(l int) bool {
+        return l <= g.verbosity
+}
+
+// sprintln is fmt.Sprintln without the trailing new line.
+func sprintln(args ...interface{}) string {
+        s := fmt.Sprintln(args...)
+        return s[:len(s)-1]
+}
@@ -0,0 +1,51 @@
+/*  grpclog_test.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 21, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 23/10/26 09:45
+ */
+
+package suki
+
+import (
+        "io/ioutil"
+        "os"
+        "runtime"
+        "testing"
+
+        "github.com/stretchr/testify/assert"
+        "go.uber.org/zap/zapcore"
+        "google.golang.org/grpc/grpclog"
+)
+
+func TestGRPCLogger(t *testing.T) {
+        l, logs := observedZap()
+        g := NewGRPCLogger(l, 2)
+        g.Info("transport: ", "closing")
+        g.Warningln("addrConn:", "connection lost")
+        g.Errorf("grpc: server failed to encode response: %v", "EOF")
+
+        entries := logs.AllUntimed()
+        assert.Len(t, entries, 3)
+        assert.Equal(t, "transport: closing", entries[0].Message)
+        assert.Equal(t, zapcore.InfoLevel, entries[0].Level)
+        assert.Equal(t, "addrConn: connection lost", entries[1].Message)
+        assert.Equal(t, zapcore.WarnLevel, entries[1].Level)
+        assert.Equal(t, zapcore.ErrorLevel, entries[2].Level)
+        assert.Equal(t, map[string]interface{}{"system": "grpc"}, entries[2].ContextMap())
+        assert.True(t, g.V(2))
+        assert.False(t, g.V(3))
+}
+
+func TestGRPCLoggerCaller(t *testing.T) {
+        l, logs := callerZap()
+        SetGRPCLogger(l, 0)
+        defer grpclog.SetLoggerV2(grpclog.NewLoggerV2(ioutil.Discard, ioutil.Discard, os.Stderr))
+
+        _, _, line, _ := runtime.Caller(0)
+        grpclog.Info("transport: ", "closing")
+        grpclog.Warningf("addrConn: %s", "connection lost")
+        grpclog.Errorln("grpc: server failed to encode response:", "EOF")
+        assertCaller(t, logs, "grpclog_test.go", line, "TestGRPCLoggerCaller")
+}
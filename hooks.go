@@ -0,0 +1,295 @@
+/*  hooks.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 21, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 23/10/26 11:00
+ */
+
+package suki
+
+import (
+        "context"
+        "crypto/sha1"
+        "encoding/hex"
+        "fmt"
+        "os"
+        "sync"
+        "time"
+
+        "go.uber.org/zap"
+        "go.uber.org/zap/zapcore"
+)
+
+// HookEntry is an Error, Fatal or Panic entry shipped to the sinks,
+// Count entries with the same Fingerprint were logged since the last batch.
+type HookEntry struct {
+        Level       string                 `json:"level"`
+        Time        time.Time              `json:"time"`
+        LastSeen    time.Time              `json:"last_seen"`
+        Message     string                 `json:"msg"`
+        Fields      map[string]interface{} `json:"fields,omitempty"`
+        Stack       string                 `json:"stack,omitempty"`
+        Fingerprint string                 `json:"fingerprint"`
+        Count       int                    `json:"count"`
+}
+
+// Sink ships a batch of entries, see WebhookSink and SentrySink.
+type Sink interface {
+        Send(ctx context.Context, entries []HookEntry) error
+}
+
+type HookOptions struct {
+        Sinks     []Sink
+        BatchSize int           // entries of a batch, 50 by default
+        Interval  time.Duration // flush a batch at least every Interval, 5 seconds by default
+        Timeout   time.Duration // timeout of a batch sent to a sink, 10 seconds by default
+        QueueSize int           // entries waiting for the batch, the others are dropped, 1024 by default
+        OnError   func(err error)
+}
+
+// Hook batches the Error entries of the suki logger and ships them to its
+// sinks, the entries with the same level, message and caller are sent once
+// per batch with their count. Add it with Options.Hooks.
+type Hook struct {
+        opts   HookOptions
+        queue  chan HookEntry
+        flushc chan chan struct{}
+        done   chan struct{}
+        closed chan struct{}
+
+        mu        sync.RWMutex // held by enqueue, stopped is set once no entry is on its way
+        stopped   bool
+        closeOnce sync.Once
+}
+
+// NewHook starts the hook, Close flushes and stops it.
+func NewHook(opts HookOptions) *Hook {
+        if opts.BatchSize <= 0 {
+                opts.BatchSize = 50
+        }
+        if opts.Interval <= 0 {
+                opts.Interval = 5 * time.Second
+        }
+        if opts.Timeout <= 0 {
+                opts.Timeout = 10 * time.Second
+        }
+        if opts.QueueSize <= 0 {
+                opts.QueueSize = 1024
+        }
+        if opts.OnError == nil {
+                // not the suki logger, the error could come back to the hook
+                opts.OnError = func(err error) {
+                        fmt.Fprintln(os.Stderr, "suki: log hook:", err)
+                }
+        }
+        h := &Hook{
+                opts:   opts,
+                queue:  make(chan HookEntry, opts.QueueSize),
+                flushc: make(chan chan struct{}),
+                done:   make(chan struct{}),
+                closed: make(chan struct{}),
+        }
+        go h.run()
+        return h
+}
+
+// Flush sends the entries logged so far.
+func (h *Hook) Flush() {
+        reply := make(chan struct{})
+        select {
+        case h.flushc <- reply:
+                <-reply
+        case <-h.closed:
+        }
+}
+
+// Close sends the entries logged so far and stops the hook, the entries
+// logged after are dropped and reported to OnError. Close is safe to call
+// more than once and concurrently.
+func (h *Hook) Close() {
+        h.closeOnce.Do(func() {
+                h.mu.Lock()
+                h.stopped = true
+                h.mu.Unlock()
+                close(h.done)
+        })
+        <-h.closed
+}
+
+// Core returns the core of the hook, a Tee adds it to another core.
+func (h *Hook) Core() Core {
+        return &hookCore{hook: h}
+}
+
+func (h *Hook) enqueue(e HookEntry) {
+        var err error
+        h.mu.RLock()
+        if h.stopped {
+                err = fmt.Errorf("hook closed, entry %q dropped", e.Message)
+        } else {
+                select {
+                case h.queue <- e:
+                default:
+                        err = fmt.Errorf("queue full, entry %q dropped", e.Message)
+                }
+        }
+        h.mu.RUnlock()
+        // OnError may log, out of the lock
+        if err != nil {
+                h.opts.OnError(err)
+        }
+}
+
+func (h *Hook) run() {
+        defer close(h.closed)
+        ticker := time.NewTicker(h.opts.Interval)
+        defer ticker.Stop()
+        batch := newHookBatch()
+        for {
+                select {
+                case e := <-h.queue:
+                        h.add(batch, e)
+                case <-ticker.C:
+                        h.send(batch.take())
+                case reply := <-h.flushc:
+                        h.drain(batch)
+                        h.send(batch.take())
+                        close(reply)
+                case <-h.done:
+                        h.drain(batch)
+                        h.send(batch.take())
+                        return
+                }
+        }
+}
+
+func (h *Hook) add(batch *hookBatch, e HookEntry) {
+        batch.add(e)
+        if batch.len() >= h.opts.BatchSize {
+                h.send(batch.take())
+        }
+}
+
+func (h *Hook) drain(batch *hookBatch) {
+        for {
+                select {
+                case e := <-h.queue:
+                        h.add(batch, e)
+                default:
+                        return
+                }
+        }
+}
+
+func (h *Hook) send(entries []HookEntry) {
+        if len(entries) == 0 {
+                return
+        }
+        for _, sink := range h.opts.Sinks {
+                ctx, cancel := context.WithTimeout(context.Background(), h.opts.Timeout)
+                if err := sink.Send(ctx, entries); err != nil {
+                        h.opts.OnError(err)
+                }
+                cancel()
+        }
+}
+
+// hookBatch deduplicates the entries by fingerprint, in logged order.
+type hookBatch struct {
+        order   []string
+        entries map[string]*HookEntry
+}
+
+func newHookBatch() *hookBatch {
+        return &hookBatch{entries: make(map[string]*HookEntry)}
+}
+
+func (b *hookBatch) add(e HookEntry) {
+        if seen, ok := b.entries[e.Fingerprint]; ok {
+                seen.Count++
+                seen.LastSeen = e.Time
+                return
+        }
+        e.Count, e.LastSeen = 1, e.Time
+        b.entries[e.Fingerprint] = &e
+        b.order = append(b.order, e.Fingerprint)
+}
+
+func (b *hookBatch) len() int {
+        return len(b.order)
+}
+
+func (b *hookBatch) take() []HookEntry {
+        entries := make([]HookEntry, 0, len(b.order))
+        for _, fp := range b.order {
+                entries = append(entries, *b.entries[fp])
+        }
+        b.order, b.entries = nil, make(map[string]*HookEntry)
+        return entries
+}
+
+// Fingerprint identifies the entries of the same level, message and caller.
+func Fingerprint(level, msg, caller string) string {
+        sum := sha1.Sum([]byte(level + "\x00" + msg + "\x00" + caller))
+        return hex.EncodeToString(sum[:8])
+}
+
+// hookCore is the leaf core of a hook, enabled for Error and above.
+type hookCore struct {
+        hook   *Hook
+        fields []ZapField
+}
+
+func (c *hookCore) Enabled(lvl zapcore.Level) bool {
+        return lvl >= zapcore.ErrorLevel
+}
+
+func (c *hookCore) With(fields []ZapField) Core {
+        all := make([]ZapField, 0, len(c.fields)+len(fields))
+        all = append(append(all, c.fields...), fields...)
+        return &hookCore{hook: c.hook, fields: all}
+}
+
+func (c *hookCore) Check(e zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+        if c.Enabled(e.Level) {
+                return ce.AddCore(e, c)
+        }
+        return ce
+}
+
+func (c *hookCore) Write(e zapcore.Entry, fields []ZapField) error {
+        enc := zapcore.NewMapObjectEncoder()
+        for _, f := range c.fields {
+                f.AddTo(enc)
+        }
+        for _, f := range fields {
+                f.AddTo(enc)
+        }
+        stack := e.Stack
+        if stack == "" {
+                stack = zap.Stack("").String
+        }
+        caller, _ := enc.Fields["caller"].(string)
+        if caller == "" && e.Caller.Defined {
+                caller = e.Caller.TrimmedPath()
+        }
+        c.hook.enqueue(HookEntry{
+                Level:       e.Level.String(),
+                Time:        e.Time,
+                Message:     e.Message,
+                Fields:      enc.Fields,
+                Stack:       stack,
+                Fingerprint: Fingerprint(e.Level.String(), e.Message, caller),
+        })
+        // the process ends after a Fatal or Panic entry
+        if e.Level > zapcore.ErrorLevel {
+                c.hook.Flush()
+        }
+        return nil
+}
+
+func (c *hookCore) Sync() error {
+        c.hook.Flush()
+        return nil
+}
@@ -0,0 +1,250 @@
+/*  hooks_test.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 21, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 24/10/26 13:00
+ */
+
+package suki
+
+import (
+        "bufio"
+        "bytes"
+        "context"
+        "encoding/json"
+        "io/ioutil"
+        "net/http"
+        "net/http/httptest"
+        "strings"
+        "sync"
+        "sync/atomic"
+        "testing"
+        "time"
+
+        "github.com/stretchr/testify/assert"
+        "github.com/stretchr/testify/require"
+        "go.uber.org/zap/zapcore"
+)
+
+type receiver struct {
+        mu      sync.Mutex
+        headers []http.Header
+        bodies  [][]byte
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+        body, _ := ioutil.ReadAll(req.Body)
+        r.mu.Lock()
+        r.headers = append(r.headers, req.Header)
+        r.bodies = append(r.bodies, body)
+        r.mu.Unlock()
+        w.WriteHeader(http.StatusAccepted)
+}
+
+func hookedZap(h *Hook) Logging {
+        return newZapLog(Options{
+                Outputs: []zapcore.WriteSyncer{zapcore.AddSync(ioutil.Discard)},
+                Hooks:   []*Hook{h},
+        })
+}
+
+func TestWebhookSink(t *testing.T) {
+        rcv := &receiver{}
+        srv := httptest.NewServer(rcv)
+        defer srv.Close()
+        h := NewHook(HookOptions{
+                Sinks:    []Sink{&WebhookSink{URL: srv.URL, Service: "courier"}},
+                Interval: time.Hour,
+        })
+        defer h.Close()
+
+        l := hookedZap(h)
+        for i := 0; i < 3; i++ {
+                l.Error("deliver work order failed", String("order", "INV-7"), String("password", "s3cret"))
+        }
+        l.Warn("slow courier")
+        l.Error("geocode failed")
+        h.Flush()
+
+        require.Len(t, rcv.bodies, 1)
+        assert.Equal(t, "application/json", rcv.headers[0].Get("Content-Type"))
+        var batch struct {
+                Service string      `json:"service"`
+                Entries []HookEntry `json:"entries"`
+        }
+        require.NoError(t, json.Unmarshal(rcv.bodies[0], &batch))
+        assert.Equal(t, "courier", batch.Service)
+        require.Len(t, batch.Entries, 2, "the warning is not hooked, the repeated error is sent once")
+        first := batch.Entries[0]
+        assert.Equal(t, "deliver work order failed", first.Message)
+        assert.Equal(t, "error", first.Level)
+        assert.Equal(t, 3, first.Count)
+        assert.Equal(t, "INV-7", first.Fields["order"])
+        assert.Equal(t, Redacted, first.Fields["password"])
+        assert.NotEmpty(t, first.Stack)
+        assert.NotEqual(t, first.Fingerprint, batch.Entries[1].Fingerprint)
+
+        h.Flush()
+        assert.Len(t, rcv.bodies, 1, "an empty batch is not sent")
+}
+
+func TestHookBatchSize(t *testing.T) {
+        rcv := &receiver{}
+        srv := httptest.NewServer(rcv)
+        defer srv.Close()
+        h := NewHook(HookOptions{
+                Sinks:     []Sink{&WebhookSink{URL: srv.URL}},
+                BatchSize: 2,
+                Interval:  time.Hour,
+        })
+
+        l := hookedZap(h)
+        l.Error("first")
+        l.Error("second")
+        l.Error("third")
+        h.Close()
+
+        rcv.mu.Lock()
+        defer rcv.mu.Unlock()
+        assert.Len(t, rcv.bodies, 2)
+}
+
+// countSink counts the entries sent.
+type countSink struct {
+        n int64
+}
+
+func (s *countSink) Send(_ context.Context, entries []HookEntry) error {
+        for _, e := range entries {
+                atomic.AddInt64(&s.n, int64(e.Count))
+        }
+        return nil
+}
+
+func TestHookClose(t *testing.T) {
+        sink := &countSink{}
+        var dropped int64
+        h := NewHook(HookOptions{
+                Sinks:    []Sink{sink},
+                Interval: time.Hour,
+                OnError: func(err error) {
+                        if strings.HasPrefix(err.Error(), "hook closed") {
+                                atomic.AddInt64(&dropped, 1)
+                        }
+                },
+        })
+        l := hookedZap(h)
+
+        var wg sync.WaitGroup
+        for i := 0; i < 8; i++ {
+                wg.Add(2)
+                go func() {
+                        defer wg.Done()
+                        for j := 0; j < 50; j++ {
+                                l.Error("deliver work order failed")
+                        }
+                }()
+                go func() {
+                        defer wg.Done()
+                        h.Close()
+                }()
+        }
+        wg.Wait()
+        assert.Equal(t, int64(8*50), atomic.LoadInt64(&sink.n)+atomic.LoadInt64(&dropped), "every entry is sent or reported")
+
+        l.Error("geocode failed")
+        assert.Equal(t, int64(8*50+1), atomic.LoadInt64(&sink.n)+atomic.LoadInt64(&dropped), "dropped after Close")
+        h.Close()
+}
+
+func TestSentrySink(t *testing.T) {
+        rcv := &receiver{}
+        srv := httptest.NewServer(rcv)
+        defer srv.Close()
+        dsn := strings.Replace(srv.URL, "http://", "http://publickey@", 1) + "/42"
+        sink := &SentrySink{DSN: dsn, Environment: "staging", Release: "1.4.0"}
+
+        now := time.Now()
+        err := sink.Send(context.Background(), []HookEntry{{
+                Level:       "panic",
+                Time:        now,
+                Message:     "courier panicked",
+                Fields:      map[string]interface{}{"order": "INV-7"},
+                Fingerprint: Fingerprint("panic", "courier panicked", "courier.go:12"),
+                Count:       2,
+        }})
+        require.NoError(t, err)
+        require.Len(t, rcv.bodies, 1)
+        assert.Equal(t, "Sentry sentry_version=7, sentry_client=suki/1.0, sentry_key=publickey",
+                rcv.headers[0].Get("X-Sentry-Auth"))
+
+        lines := bufio.NewScanner(bytes.NewReader(rcv.bodies[0]))
+        var header, item map[string]interface{}
+        var event struct {
+                EventID     string                 `json:"event_id"`
+                Level       string                 `json:"level"`
+                Environment string                 `json:"environment"`
+                Message     map[string]string      `json:"message"`
+                Fingerprint []string               `json:"fingerprint"`
+                Extra       map[string]interface{} `json:"extra"`
+        }
+        require.True(t, lines.Scan())
+        require.NoError(t, json.Unmarshal(lines.Bytes(), &header))
+        require.True(t, lines.Scan())
+        require.NoError(t, json.Unmarshal(lines.Bytes(), &item))
+        require.True(t, lines.Scan())
+        assert.Equal(t, float64(len(lines.Bytes())), item["length"])
+        require.NoError(t, json.Unmarshal(lines.Bytes(), &event))
+
+        assert.Equal(t, header["event_id"], event.EventID)
+        assert.Equal(t, "event", item["type"])
+        assert.Equal(t, "fatal", event.Level)
+        assert.Equal(t, "staging", event.Environment)
+        assert.Equal(t, "courier panicked", event.Message["formatted"])
+        assert.Len(t, event.Fingerprint, 1)
+        assert.Equal(t, "INV-7", event.Extra["order"])
+        assert.Equal(t, float64(2), event.Extra["count"])
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+        return f(r)
+}
+
+func TestSentrySinkPartialFailure(t *testing.T) {
+        rcv := &receiver{}
+        var calls int32
+        srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+                if atomic.AddInt32(&calls, 1) == 1 {
+                        w.WriteHeader(http.StatusTooManyRequests)
+                        return
+                }
+                rcv.ServeHTTP(w, r)
+        }))
+        defer srv.Close()
+        dsn := strings.Replace(srv.URL, "http://", "http://publickey@", 1) + "/42"
+        // a transport adding a header must not leak it into the next request
+        client := &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+                r.Header.Add("X-Attempt", "1")
+                return http.DefaultTransport.RoundTrip(r)
+        })}
+        sink := &SentrySink{DSN: dsn, Client: client}
+
+        err := sink.Send(context.Background(), []HookEntry{
+                {Level: "error", Message: "geocode failed"},
+                {Level: "error", Message: "route failed"},
+                {Level: "error", Message: "payment failed"},
+        })
+        assert.Error(t, err)
+        assert.Contains(t, err.Error(), "429")
+        assert.EqualValues(t, 3, atomic.LoadInt32(&calls), "every entry is sent")
+        require.Len(t, rcv.headers, 2)
+        assert.Equal(t, []string{"1"}, rcv.headers[1].Values("X-Attempt"))
+}
+
+func TestSentrySinkBadDSN(t *testing.T) {
+        sink := &SentrySink{DSN: "https://sentry.example.com/42"}
+        assert.Error(t, sink.Send(context.Background(), []HookEntry{{Message: "lost"}}))
+}
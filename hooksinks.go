@@ -0,0 +1,160 @@
+/*  hooksinks.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 21, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 24/10/26 13:00
+ */
+
+package suki
+
+import (
+        "bytes"
+        "context"
+        "crypto/rand"
+        "encoding/hex"
+        "encoding/json"
+        "errors"
+        "fmt"
+        "io"
+        "io/ioutil"
+        "net/http"
+        "net/url"
+        "strings"
+        "time"
+)
+
+// WebhookSink posts every batch as {"service": ..., "entries": [...]}.
+type WebhookSink struct {
+        URL     string
+        Service string
+        Header  http.Header  // e.g. the Authorization of the receiver
+        Client  *http.Client // http.DefaultClient by default
+}
+
+func (s *WebhookSink) Send(ctx context.Context, entries []HookEntry) error {
+        body, err := json.Marshal(struct {
+                Service string      `json:"service,omitempty"`
+                Entries []HookEntry `json:"entries"`
+        }{s.Service, entries})
+        if err != nil {
+                return err
+        }
+        header := http.Header{"Content-Type": {"application/json"}}
+        for k, v := range s.Header {
+                header[k] = v
+        }
+        return post(ctx, s.Client, s.URL, header, body)
+}
+
+// SentrySink sends every entry as an event envelope to the project of
+// the DSN, https://<key>@<host>/<project id>. The fingerprint groups the
+// events of an entry, the fields and the count go to the extra data.
+type SentrySink struct {
+        DSN         string
+        Environment string
+        Release     string
+        Client      *http.Client // http.DefaultClient by default
+}
+
+func (s *SentrySink) Send(ctx context.Context, entries []HookEntry) error {
+        endpoint, auth, err := s.endpoint()
+        if err != nil {
+                return err
+        }
+        header := http.Header{
+                "Content-Type":  {"application/x-sentry-envelope"},
+                "X-Sentry-Auth": {auth},
+        }
+        // an entry failing does not hold back the others
+        var errs []error
+        for _, e := range entries {
+                body, err := s.envelope(e)
+                if err == nil {
+                        err = post(ctx, s.Client, endpoint, header, body)
+                }
+                if err != nil {
+                        errs = append(errs, err)
+                }
+        }
+        return errors.Join(errs...)
+}
+
+func (s *SentrySink) endpoint() (string, string, error) {
+        dsn, err := url.Parse(s.DSN)
+        if err != nil {
+                return "", "", err
+        }
+        project := strings.Trim(dsn.Path, "/")
+        if dsn.User == nil || dsn.User.Username() == "" || project == "" {
+                return "", "", fmt.Errorf("suki: bad sentry dsn %q", dsn.Redacted())
+        }
+        endpoint := url.URL{Scheme: dsn.Scheme, Host: dsn.Host, Path: "/api/" + project + "/envelope/"}
+        auth := "Sentry sentry_version=7, sentry_client=suki/1.0, sentry_key=" + dsn.User.Username()
+        return endpoint.String(), auth, nil
+}
+
+func (s *SentrySink) envelope(e HookEntry) ([]byte, error) {
+        id := make([]byte, 16)
+        if _, err := rand.Read(id); err != nil {
+                return nil, err
+        }
+        eventID := hex.EncodeToString(id)
+        level := e.Level
+        if level == "dpanic" || level == "panic" {
+                level = "fatal"
+        }
+        extra := make(map[string]interface{}, len(e.Fields)+2)
+        for k, v := range e.Fields {
+                extra[k] = v
+        }
+        extra["count"] = e.Count
+        if e.Stack != "" {
+                extra["stack"] = e.Stack
+        }
+        event, err := json.Marshal(map[string]interface{}{
+                "event_id":    eventID,
+                "timestamp":   e.Time.UTC().Format(time.RFC3339Nano),
+                "level":       level,
+                "logger":      "suki",
+                "platform":    "go",
+                "message":     map[string]string{"formatted": e.Message},
+                "fingerprint": []string{e.Fingerprint},
+                "environment": s.Environment,
+                "release":     s.Release,
+                "extra":       extra,
+        })
+        if err != nil {
+                return nil, err
+        }
+        var buf bytes.Buffer
+        _ = json.NewEncoder(&buf).Encode(map[string]string{
+                "event_id": eventID,
+                "sent_at":  time.Now().UTC().Format(time.RFC3339Nano),
+        })
+        _ = json.NewEncoder(&buf).Encode(map[string]interface{}{"type": "event", "length": len(event)})
+        buf.Write(event)
+        buf.WriteByte('\n')
+        return buf.Bytes(), nil
+}
+
+func post(ctx context.Context, client *http.Client, endpoint string, header http.Header, body []byte) error {
+        if client == nil {
+                client = http.DefaultClient
+        }
+        req, err := http.NewRequest(http.MethodPost, endpoint, bytes.NewReader(body))
+        if err != nil {
+                return err
+        }
+        req.Header = header.Clone()
+        resp, err := client.Do(req.WithContext(ctx))
+        if err != nil {
+                return err
+        }
+        defer resp.Body.Close()
+        _, _ = io.Copy(ioutil.Discard, resp.Body)
+        if resp.StatusCode >= http.StatusMultipleChoices {
+                return fmt.Errorf("suki: %s answered %s", endpoint, resp.Status)
+        }
+        return nil
+}
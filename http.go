@@ -16,6 +16,7 @@ import (
         "net/url"
         "os"
         "os/signal"
+        "strconv"
         "strings"
         "sync"
         "syscall"
@@ -46,6 +47,10 @@ type CmdHttp interface {
         GRPCHandler(handler *grpc.Server)
 }
 
+// DrainTimeout is how long a graceful restart waits for the requests in
+// flight when the command has no write timeout.
+var DrainTimeout = 30 * time.Second
+
 type cmdHttp struct {
         stop <-chan bool // stop chan signal for server
 
@@ -53,10 +58,15 @@ type cmdHttp struct {
         ReadTimeout  int
         WriteTimeout int
         Filename     string
+        Socket       string
+        SocketMode   string
+        AdminAddr    string
+        AdminToken   string
         Cmd          *cobra.Command
         handler      http.Handler
         grpcHandler  *grpc.Server
         srv          *Server
+        admin        *Server
 }
 
 // ServerBaseContext wraps an http.Handler to set the request context to the
@@ -78,32 +88,103 @@ func (c *cmdHttp) GRPCHandler(handler *grpc.Server) {
         c.grpcHandler = handler
 }
 
+// address returns the url the server listens on, a unix socket when set.
+func (c *cmdHttp) address() url.URL {
+        if c.Socket != "" {
+                return url.URL{Scheme: "unix", Path: c.Socket}
+        }
+        return url.URL{Scheme: "http", Host: fmt.Sprintf(":%v", c.Port)}
+}
+
+func (c *cmdHttp) socketMode() os.FileMode {
+        mode, err := strconv.ParseUint(c.SocketMode, 8, 32)
+        if err != nil {
+                return DefaultSocketMode
+        }
+        return os.FileMode(mode)
+}
+
 func (c *cmdHttp) handlerFunc(ctx context.Context, handler http.Handler) error {
-        go func() {
-                defer c.srv.Stop()
-                <-ctx.Done()
-                Info("I have to go...")
-                Info("Stopping server gracefully")
-        }()
-        addrURL := url.URL{Scheme: "http", Host: fmt.Sprintf(":%v", c.Port)}
+        addrURL := c.address()
+        listener, err := Listen(addrURL, c.socketMode())
+        if err != nil {
+                return err
+        }
         Info(fmt.Sprintf("started server %s", addrURL.String()))
-        c.srv = StartWebServer(
+        c.srv = StartWebServerListener(
+                listener,
                 addrURL,
                 c.ReadTimeout,
                 c.WriteTimeout,
                 handler,
         )
+        if c.AdminAddr != "" {
+                routes, _ := c.handler.(RouteLister)
+                c.admin, err = StartAdminServer(c.AdminAddr, AdminOptions{Token: c.AdminToken, Routes: routes})
+                if err != nil {
+                        c.srv.Stop()
+                        return err
+                }
+                defer c.admin.Stop()
+        }
+        NotifyReady()
+        go func() {
+                defer c.srv.Stop()
+                <-ctx.Done()
+                Info("I have to go...")
+                Info("Stopping server gracefully")
+        }()
         sc := make(chan os.Signal, 10)
-        signal.Notify(sc, os.Interrupt, syscall.SIGTERM)
-        select {
-        case s := <-sc:
-                Info(fmt.Sprintf("shutting down server with signal %q", s.String()))
-        case <-c.stop:
-                Info("shutting down server with stop channel")
-        case <-c.srv.StopNotify():
-                Info("shutting down server with stop signal")
+        signal.Notify(sc, append([]os.Signal{os.Interrupt, syscall.SIGTERM}, reexecSignals...)...)
+        defer signal.Stop(sc)
+        for {
+                select {
+                case s := <-sc:
+                        if !isReexecSignal(s) {
+                                Info(fmt.Sprintf("shutting down server with signal %q", s.String()))
+                                return nil
+                        }
+                        if c.restart() {
+                                return nil
+                        }
+                case <-c.stop:
+                        Info("shutting down server with stop channel")
+                        return nil
+                case <-c.srv.StopNotify():
+                        Info("shutting down server with stop signal")
+                        return nil
+                }
+        }
+}
+
+// restart hands the listener over to a new process of the same binary
+// and drains the connections of this one once the new process is ready.
+func (c *cmdHttp) restart() bool {
+        listeners := []net.Listener{c.srv.Listener()}
+        if c.admin != nil {
+                listeners = append(listeners, c.admin.Listener())
+        }
+        p, err := Reexec(listeners...)
+        if err != nil {
+                Error("graceful restart failed", Field("error", err))
+                return false
+        }
+        grace := time.Duration(c.WriteTimeout) * time.Second
+        if grace <= 0 {
+                grace = DrainTimeout
         }
-        return nil
+        Info(fmt.Sprintf("handed over listener to process %d, draining connections", p.Pid))
+        c.srv.Shutdown(grace)
+        return true
+}
+
+func isReexecSignal(s os.Signal) bool {
+        for _, sig := range reexecSignals {
+                if s == sig {
+                        return true
+                }
+        }
+        return false
 }
 
 func (c *cmdHttp) command(cmd *cobra.Command, args []string) error {
@@ -148,6 +229,10 @@ func NewCmdHttpSignaled(
                 Short: "Used to run the http service",
                 RunE:  c.command,
         }
+        c.Cmd.Flags().StringVar(&c.Socket, "socket", "", "listen on a unix domain socket instead of the port")
+        c.Cmd.Flags().StringVar(&c.SocketMode, "socket-mode", "0660", "permission of the unix domain socket")
+        c.Cmd.Flags().StringVar(&c.AdminAddr, "admin-addr", "", "address of the admin server, e.g. 127.0.0.1:6060")
+        c.Cmd.Flags().StringVar(&c.AdminToken, "admin-token", os.Getenv("SUKI_ADMIN_TOKEN"), "bearer token of the admin server")
         return c
 }
 
@@ -156,6 +241,7 @@ type Server struct {
         mu         sync.RWMutex
         addrURL    url.URL
         httpServer *http.Server
+        listener   net.Listener
 
         stopc chan struct{}
         donec chan struct{}
@@ -166,15 +252,25 @@ func (srv *Server) StopNotify() <-chan struct{} {
         return srv.stopc
 }
 
+// Listener returns the listener the server accepts connections on.
+func (srv *Server) Listener() net.Listener {
+        return srv.listener
+}
+
 // Stop stops the server. Useful for testing.
 func (srv *Server) Stop() {
+        srv.Shutdown(time.Duration(50))
+}
+
+// Shutdown stops the server, waiting up to graceTimeOut for active
+// connections to finish before closing them.
+func (srv *Server) Shutdown(graceTimeOut time.Duration) {
         Warn(fmt.Sprintf("stopping server %s", srv.addrURL.String()))
         srv.mu.Lock()
         if srv.httpServer == nil {
                 srv.mu.Unlock()
                 return
         }
-        graceTimeOut := time.Duration(50)
         ctx, cancel := context.WithTimeout(context.Background(), graceTimeOut)
         defer cancel()
         if err := srv.httpServer.Shutdown(ctx); err != nil {
@@ -186,12 +282,24 @@ func (srv *Server) Stop() {
         }
         close(srv.stopc)
         <-srv.donec
+        srv.httpServer = nil
         srv.mu.Unlock()
         Warn(fmt.Sprintf("stopped server %s", srv.addrURL.String()))
 }
 
-// StartWebServer starts a web server
+// StartWebServer starts a web server, the process exits when
+// the address can not be listened on.
 func StartWebServer(addr url.URL, readTimeout, writeTimeout int, handler http.Handler) *Server {
+        listener, err := Listen(addr, DefaultSocketMode)
+        if err != nil {
+                Fatal("listen failed", Field("addr", addr.String()), Field("error", err))
+        }
+        return StartWebServerListener(listener, addr, readTimeout, writeTimeout, handler)
+}
+
+// StartWebServerListener starts a web server on an existing listener,
+// such as one passed by systemd socket activation.
+func StartWebServerListener(listener net.Listener, addr url.URL, readTimeout, writeTimeout int, handler http.Handler) *Server {
         stopc := make(chan struct{})
         srv := &Server{
                 addrURL: addr,
@@ -201,12 +309,9 @@ func StartWebServer(addr url.URL, readTimeout, writeTimeout int, handler http.Ha
                         ReadTimeout:  time.Duration(readTimeout) * time.Second,
                         WriteTimeout: time.Duration(writeTimeout) * time.Second,
                 },
-                stopc: stopc,
-                donec: make(chan struct{}),
-        }
-        listener, err := net.Listen("tcp", addr.Host)
-        if err != nil {
-                Error(err.Error())
+                listener: listener,
+                stopc:    stopc,
+                donec:    make(chan struct{}),
         }
         go func() {
                 defer func() {
@@ -239,6 +344,17 @@ const (
         StatusAccepted              = http.StatusAccepted
         StatusForbidden             = http.StatusForbidden
         StatusInvalidAuthentication = http.StatusProxyAuthRequired
+        StatusNoContent             = http.StatusNoContent
+        StatusNotFound              = http.StatusNotFound
+        StatusMethodNotAllowed      = http.StatusMethodNotAllowed
+        StatusNotAcceptable         = http.StatusNotAcceptable
+        StatusConflict              = http.StatusConflict
+        StatusRequestTooLarge       = http.StatusRequestEntityTooLarge
+        StatusUnsupportedMedia      = http.StatusUnsupportedMediaType
+        StatusUnprocessableEntity   = http.StatusUnprocessableEntity
+        StatusTooManyRequests       = http.StatusTooManyRequests
+        StatusServiceUnavailable    = http.StatusServiceUnavailable
+        StatusGatewayTimeout        = http.StatusGatewayTimeout
 )
 
 var statusMap = map[int][]string{
@@ -251,12 +367,39 @@ var statusMap = map[int][]string{
         StatusAccepted:              {"STATUS_ACCEPTED", "Resource has been accepted"},
         StatusForbidden:             {"STATUS_FORBIDDEN", "Forbidden access the resource "},
         StatusInvalidAuthentication: {"STATUS_INVALID_AUTHENTICATION", "The resource owner or authorization server denied the request"},
+        StatusNoContent:             {"STATUS_NO_CONTENT", "Request has been processed"},
+        StatusNotFound:              {"STATUS_NOT_FOUND", "Resource not found"},
+        StatusMethodNotAllowed:      {"STATUS_METHOD_NOT_ALLOWED", "Method is not allowed for the resource"},
+        StatusNotAcceptable:         {"STATUS_NOT_ACCEPTABLE", "Requested representation is not available"},
+        StatusConflict:              {"STATUS_CONFLICT", "Resource conflicts with the current state"},
+        StatusRequestTooLarge:       {"STATUS_REQUEST_TOO_LARGE", "Request body is too large"},
+        StatusUnsupportedMedia:      {"STATUS_UNSUPPORTED_MEDIA_TYPE", "Content type is not supported"},
+        StatusUnprocessableEntity:   {"STATUS_UNPROCESSABLE_ENTITY", "Request could not be processed"},
+        StatusTooManyRequests:       {"STATUS_TOO_MANY_REQUESTS", "Too many requests, please try again later"},
+        StatusServiceUnavailable:    {"STATUS_SERVICE_UNAVAILABLE", "Service is temporarily unavailable"},
+        StatusGatewayTimeout:        {"STATUS_GATEWAY_TIMEOUT", "Upstream service did not respond in time"},
 }
 
+// StatusCode returns the status code name, statuses missing from the
+// status map are derived from the http status text.
 func StatusCode(code int) string {
-        return statusMap[code][0]
+        if s, ok := statusMap[code]; ok {
+                return s[0]
+        }
+        text := http.StatusText(code)
+        if text == "" {
+                return "STATUS_UNKNOWN"
+        }
+        return "STATUS_" + strings.ToUpper(strings.NewReplacer(" ", "_", "-", "_", "'", "").Replace(text))
 }
 
+// StatusText returns the message of the status code.
 func StatusText(code int) string {
-        return statusMap[code][1]
+        if s, ok := statusMap[code]; ok {
+                return s[1]
+        }
+        if text := http.StatusText(code); text != "" {
+                return text
+        }
+        return statusMap[StatusInternalError][1]
 }
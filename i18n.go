@@ -0,0 +1,201 @@
+/*  i18n.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 21, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 21/10/26 09:30
+ */
+
+package suki
+
+import (
+        "context"
+        "fmt"
+        "net/http"
+        "sort"
+        "strconv"
+        "strings"
+        "sync"
+)
+
+// DefaultLocale is the locale of the messages when the request has none,
+// its bundle is also the fallback of a message missing in another locale.
+var DefaultLocale = "en"
+
+type ctxKeyLocale struct {
+        Name string
+}
+
+func (r *ctxKeyLocale) String() string {
+        return "context value " + r.Name
+}
+
+var CtxLocale = ctxKeyLocale{Name: "context locale"}
+
+// Message keys of the bundles, a status text is "status.<code>" such as
+// "status.404" and an AppError message is "error.<code>" such as
+// "error.COURIER_BUSY".
+const (
+        MsgInvalidInput     = "validation.invalid" // value, field
+        MsgUnknownInput     = "validation.unknown" // field
+        MsgMalformedBody    = "validation.malformed"
+        MsgUnsupportedMedia = "validation.media" // content type
+)
+
+var (
+        bundlesMu sync.RWMutex
+        bundles   = map[string]map[string]string{
+                "en": {
+                        MsgInvalidInput:     "Invalid Type %v for input %s",
+                        MsgUnknownInput:     "Unknown input %s",
+                        MsgMalformedBody:    "Request body is malformed",
+                        MsgUnsupportedMedia: "Content type %q is not supported",
+                },
+                "id": {
+                        MsgInvalidInput:     "Tipe %v tidak valid untuk input %s",
+                        MsgUnknownInput:     "Input %s tidak dikenal",
+                        MsgMalformedBody:    "Format body permintaan tidak valid",
+                        MsgUnsupportedMedia: "Tipe konten %q tidak didukung",
+
+                        "status.200": "Berhasil",
+                        "status.201": "Sumber daya telah dibuat",
+                        "status.202": "Sumber daya telah diterima",
+                        "status.204": "Permintaan telah diproses",
+                        "status.400": "Data permintaan tidak valid",
+                        "status.401": "Tidak berwenang mengakses layanan",
+                        "status.403": "Akses ke sumber daya ditolak",
+                        "status.404": "Sumber daya tidak ditemukan",
+                        "status.405": "Metode tidak diizinkan untuk sumber daya ini",
+                        "status.406": "Representasi yang diminta tidak tersedia",
+                        "status.407": "Pemilik sumber daya atau server otorisasi menolak permintaan",
+                        "status.409": "Sumber daya bertentangan dengan kondisi saat ini",
+                        "status.413": "Body permintaan terlalu besar",
+                        "status.415": "Tipe konten tidak didukung",
+                        "status.422": "Permintaan tidak dapat diproses",
+                        "status.429": "Terlalu banyak permintaan, silakan coba lagi nanti",
+                        "status.500": "Ups, terjadi kesalahan",
+                        "status.502": "Ups, terjadi kesalahan",
+                        "status.503": "Layanan sedang tidak tersedia",
+                        "status.504": "Layanan tujuan tidak merespons tepat waktu",
+                },
+        }
+)
+
+// RegisterMessages adds the messages to the bundle of the locale,
+// creating the bundle of a new locale.
+func RegisterMessages(locale string, messages map[string]string) {
+        bundlesMu.Lock()
+        defer bundlesMu.Unlock()
+        locale = strings.ToLower(locale)
+        if bundles[locale] == nil {
+                bundles[locale] = make(map[string]string, len(messages))
+        }
+        for k, v := range messages {
+                bundles[locale][k] = v
+        }
+}
+
+// Locales returns the locales with a message bundle.
+func Locales() []string {
+        bundlesMu.RLock()
+        defer bundlesMu.RUnlock()
+        locales := make([]string, 0, len(bundles))
+        for locale := range bundles {
+                locales = append(locales, locale)
+        }
+        sort.Strings(locales)
+        return locales
+}
+
+func lookup(locale, key string) (string, bool) {
+        bundlesMu.RLock()
+        defer bundlesMu.RUnlock()
+        if msg, ok := bundles[locale][key]; ok {
+                return msg, true
+        }
+        msg, ok := bundles[DefaultLocale][key]
+        return msg, ok
+}
+
+// T returns the message of the key in the locale formatted with args,
+// falling back to the default locale and to the key itself.
+func T(locale, key string, args ...interface{}) string {
+        msg, ok := lookup(locale, key)
+        if !ok {
+                msg = key
+        }
+        if len(args) == 0 {
+                return msg
+        }
+        return fmt.Sprintf(msg, args...)
+}
+
+// StatusTextLocale returns the message of the status code in the locale.
+func StatusTextLocale(locale string, code int) string {
+        if msg, ok := lookup(locale, "status."+strconv.Itoa(code)); ok {
+                return msg
+        }
+        return StatusText(code)
+}
+
+// WithLocale returns a context carrying the locale of the messages.
+func WithLocale(ctx context.Context, locale string) context.Context {
+        return context.WithValue(ctx, CtxLocale, locale)
+}
+
+// Locale returns the locale of the context, see ruuto.Locale
+// to negotiate it for every request of a router.
+func Locale(ctx context.Context) string {
+        if locale, ok := ctx.Value(CtxLocale).(string); ok && locale != "" {
+                return locale
+        }
+        return DefaultLocale
+}
+
+// NegotiateLocale returns the locale of the request chosen from the query
+// parameter param, when set, or the Accept-Language header among the
+// locales with a bundle. A tag such as id-ID matches the locale id.
+func NegotiateLocale(r *http.Request, param string) string {
+        bundlesMu.RLock()
+        defer bundlesMu.RUnlock()
+        match := func(tag string) (string, bool) {
+                tag = strings.ToLower(strings.TrimSpace(tag))
+                if _, ok := bundles[tag]; ok {
+                        return tag, true
+                }
+                base := strings.SplitN(strings.Replace(tag, "_", "-", 1), "-", 2)[0]
+                _, ok := bundles[base]
+                return base, ok
+        }
+        if param != "" {
+                if locale, ok := match(r.URL.Query().Get(param)); ok {
+                        return locale
+                }
+        }
+        type weighted struct {
+                tag string
+                q   float64
+        }
+        tags := make([]weighted, 0)
+        for _, part := range strings.Split(r.Header.Get("Accept-Language"), ",") {
+                tag, q := part, 1.0
+                if idx := strings.Index(part, ";"); idx != -1 {
+                        tag = part[:idx]
+                        if v := strings.TrimSpace(part[idx+1:]); strings.HasPrefix(v, "q=") {
+                                q, _ = strconv.ParseFloat(v[2:], 64)
+                        }
+                }
+                if q > 0 {
+                        tags = append(tags, weighted{tag, q})
+                }
+        }
+        sort.SliceStable(tags, func(i, j int) bool {
+                return tags[i].q > tags[j].q
+        })
+        for _, t := range tags {
+                if locale, ok := match(t.tag); ok {
+                        return locale
+                }
+        }
+        return DefaultLocale
+}
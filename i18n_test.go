@@ -0,0 +1,77 @@
+/*  i18n_test.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 21, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 21/10/26 10:30
+ */
+
+package suki
+
+import (
+        "context"
+        "net/http"
+        "net/http/httptest"
+        "strings"
+        "testing"
+
+        "github.com/stretchr/testify/assert"
+)
+
+func TestNegotiateLocale(t *testing.T) {
+        dto := []struct {
+                label  string
+                target string
+                header string
+                out    string
+        }{
+                {"Test Default Locale", "/", "", "en"},
+                {"Test Accept Language Region", "/", "id-ID,id;q=0.9,en;q=0.8", "id"},
+                {"Test Accept Language Weight", "/", "fr;q=1, en;q=0.5, id;q=0.7", "id"},
+                {"Test Accept Language Excluded", "/", "id;q=0, en;q=0.1", "en"},
+                {"Test Query Parameter", "/?lang=id", "en", "id"},
+                {"Test Unknown Query Parameter", "/?lang=jv", "id", "id"},
+        }
+        for _, tt := range dto {
+                t.Run(tt.label, func(t *testing.T) {
+                        r := httptest.NewRequest(http.MethodGet, tt.target, nil)
+                        r.Header.Set("Accept-Language", tt.header)
+                        assert.Equal(t, tt.out, NegotiateLocale(r, "lang"))
+                })
+        }
+}
+
+func TestT(t *testing.T) {
+        RegisterMessages("id", map[string]string{"error.COURIER_BUSY": "Kurir sedang sibuk"})
+        RegisterMessages("en", map[string]string{"error.COURIER_BUSY": "Courier is busy"})
+
+        assert.Equal(t, "Tipe abc tidak valid untuk input email", T("id", MsgInvalidInput, "abc", "email"))
+        assert.Equal(t, "Invalid Type abc for input email", T("jv", MsgInvalidInput, "abc", "email"))
+        assert.Equal(t, "missing.key", T("id", "missing.key"))
+        assert.Equal(t, "Sumber daya tidak ditemukan", StatusTextLocale("id", StatusNotFound))
+        assert.Equal(t, StatusText(http.StatusTeapot), StatusTextLocale("id", http.StatusTeapot))
+        assert.Contains(t, Locales(), "id")
+
+        busy := NewError(StatusConflict, "COURIER_BUSY", "Courier is busy")
+        assert.Equal(t, "Kurir sedang sibuk", busy.Localize("id").Message)
+        assert.Equal(t, "Courier B is busy", busy.WithMessage("Courier B is busy").Localize("id").Message)
+        assert.Equal(t, "Sumber daya tidak ditemukan", ErrNotFound.Localize("id").Message)
+        assert.Equal(t, "Order not found", ErrNotFound.WithMessage("Order not found").Localize("id").Message)
+}
+
+func TestLocalizedResponses(t *testing.T) {
+        ctx := WithLocale(context.Background(), "id")
+        errs := ValidateCtx(ctx, DataTransferObject{Email: "nanang.jobs@gmail", Password: "sekret", Today: "2019-09-01", CourierID: 17})
+        assert.Equal(t, []Meta{{Code: "email", Type: "string", Message: "Tipe nanang.jobs@gmail tidak valid untuk input email"}}, errs)
+
+        r := httptest.NewRequest(http.MethodGet, "/orders/1", nil).WithContext(ctx)
+        w := httptest.NewRecorder()
+        WriteError(w, r, ErrNotFound)
+        assert.Equal(t, StatusNotFound, w.Code)
+        assert.Contains(t, w.Body.String(), `"error_message":"Sumber daya tidak ditemukan"`)
+
+        r.Header.Set("Accept", ContentTypeProblem)
+        w = httptest.NewRecorder()
+        WriteError(w, r, ErrNotFound)
+        assert.True(t, strings.Contains(w.Body.String(), `"title":"Sumber daya tidak ditemukan"`), w.Body.String())
+}
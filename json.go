@@ -14,8 +14,23 @@ import (
         "net/http"
 )
 
-// Write writes the data to http response writer
+// Write writes the data to http response writer, an error such as the one
+// returned by Bind is written by WriteError.
 func WriteJSON(w http.ResponseWriter, r *http.Request, v interface{}) {
+        if err, ok := v.(error); ok {
+                WriteError(w, r, err)
+                return
+        }
+        if res, ok := v.(*response); ok && WantsProblem(r) {
+                if errs, ok := res.Meta.([]Meta); ok {
+                        status, ok := ResponseStatus(w, r)
+                        if !ok {
+                                status = StatusErrorForm
+                        }
+                        WriteProblem(w, r, ProblemFromMeta(r, status, errs...))
+                        return
+                }
+        }
         buf := &bytes.Buffer{}
         enc := json.NewEncoder(buf)
         enc.SetEscapeHTML(true)
@@ -25,7 +40,7 @@ func WriteJSON(w http.ResponseWriter, r *http.Request, v interface{}) {
         }
 
         w.Header().Set("Content-Type", "application/json; charset=utf-8")
-        if status, ok := r.Context().Value(CtxResponse).(int); ok {
+        if status, ok := ResponseStatus(w, r); ok {
                 w.WriteHeader(status)
         }
         _, err := w.Write(buf.Bytes())
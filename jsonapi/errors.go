@@ -0,0 +1,63 @@
+/*  errors.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 20, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 24/10/26 11:45
+ */
+
+package jsonapi
+
+import (
+        "net/http"
+        "strconv"
+
+        "gitlab.com/suryakencana007/suki"
+)
+
+// ErrorObject is a JSON:API error object.
+type ErrorObject struct {
+        Status string       `json:"status,omitempty"`
+        Code   string       `json:"code,omitempty"`
+        Title  string       `json:"title,omitempty"`
+        Detail string       `json:"detail,omitempty"`
+        Source *ErrorSource `json:"source,omitempty"`
+}
+
+// ErrorSource points to the attribute or the query parameter in error.
+type ErrorSource struct {
+        Pointer   string `json:"pointer,omitempty"`
+        Parameter string `json:"parameter,omitempty"`
+}
+
+// FromMeta converts meta entries, such as the result of suki.Validate, to
+// error objects. The code of a meta entry names the attribute in error,
+// unless it is the status code of the response.
+func FromMeta(status int, errs ...suki.Meta) []*ErrorObject {
+        objects := make([]*ErrorObject, 0, len(errs))
+        for _, m := range errs {
+                e := &ErrorObject{
+                        Status: strconv.Itoa(status),
+                        Code:   m.Type,
+                        Title:  suki.StatusText(status),
+                        Detail: m.Message,
+                }
+                if m.Code != "" && m.Code != suki.StatusCode(status) {
+                        e.Source = &ErrorSource{Pointer: "/data/attributes/" + m.Code}
+                }
+                objects = append(objects, e)
+        }
+        return objects
+}
+
+// WriteErrors writes the meta entries as a JSON:API error document.
+func WriteErrors(w http.ResponseWriter, r *http.Request, status int, errs ...suki.Meta) {
+        writeDocument(w, status, &Document{Errors: FromMeta(status, errs...)})
+}
+
+// WriteError writes err as a JSON:API error document with the status of the
+// error, see suki.RequestError.
+func WriteError(w http.ResponseWriter, r *http.Request, err error) {
+        e := suki.RequestError(r, err)
+        WriteErrors(w, r, e.Status, e.Meta()...)
+}
@@ -0,0 +1,347 @@
+/*  jsonapi.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 20, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 24/10/26 11:45
+ */
+
+// Package jsonapi renders JSON:API documents from tagged structs, e.g.
+//
+//	type Group struct {
+//	        ID       int      `jsonapi:"primary,groups"`
+//	        Name     string   `jsonapi:"attr,name"`
+//	        Parent   *Group   `jsonapi:"relation,parent"`
+//	        Children []*Group `jsonapi:"relation,children,omitempty"`
+//	}
+//
+// The include and fields[type] query parameters select the related
+// resources and the sparse fieldsets, next to the page[number], page[size]
+// and filters[...] parameters already read by sqlx.GetPagination.
+package jsonapi
+
+import (
+        "encoding/json"
+        "errors"
+        "fmt"
+        "net/http"
+        "reflect"
+        "strings"
+
+        "gitlab.com/suryakencana007/suki"
+)
+
+const ContentType = "application/vnd.api+json"
+
+// Document is the top level of a JSON:API response.
+type Document struct {
+        Data     interface{}            `json:"data,omitempty"`
+        Errors   []*ErrorObject         `json:"errors,omitempty"`
+        Included []*Resource            `json:"included,omitempty"`
+        Meta     map[string]interface{} `json:"meta,omitempty"`
+        Links    *suki.Links            `json:"links,omitempty"`
+}
+
+// Identifier identifies a resource in a relationship.
+type Identifier struct {
+        Type string `json:"type"`
+        ID   string `json:"id"`
+}
+
+// Resource is a resource object built from a tagged struct.
+type Resource struct {
+        Type          string                   `json:"type"`
+        ID            string                   `json:"id"`
+        Attributes    map[string]interface{}   `json:"attributes,omitempty"`
+        Relationships map[string]*Relationship `json:"relationships,omitempty"`
+}
+
+// Relationship holds a *Identifier for a to-one relation,
+// a []*Identifier for a to-many relation or nil.
+type Relationship struct {
+        Data interface{} `json:"data"`
+}
+
+// MarshalJSON keeps "data": null for an empty to-one relation.
+func (r *Relationship) MarshalJSON() ([]byte, error) {
+        if r.Data == nil {
+                return []byte(`{"data":null}`), nil
+        }
+        return json.Marshal(struct {
+                Data interface{} `json:"data"`
+        }{r.Data})
+}
+
+// Options select the related resources to include by relationship path,
+// e.g. "parent" or "parent.children", and the fields to render by type.
+type Options struct {
+        Include []string
+        Fields  map[string][]string
+}
+
+// ParseOptions reads the include and fields[type] query parameters.
+func ParseOptions(r *http.Request) Options {
+        opts := Options{Fields: make(map[string][]string)}
+        query := r.URL.Query()
+        if include := query.Get("include"); include != "" {
+                opts.Include = strings.Split(include, ",")
+        }
+        for key, values := range query {
+                if strings.HasPrefix(key, "fields[") && strings.HasSuffix(key, "]") && len(values) > 0 {
+                        opts.Fields[key[len("fields["):len(key)-1]] = strings.Split(values[0], ",")
+                }
+        }
+        return opts
+}
+
+// field is a struct field described by its jsonapi tag.
+type field struct {
+        index     int
+        kind      string // primary, attr or relation
+        name      string // resource type of the primary field
+        omitempty bool
+}
+
+func fields(t reflect.Type) ([]field, error) {
+        fs := make([]field, 0)
+        primary := false
+        for i := 0; i < t.NumField(); i++ {
+                tag, ok := t.Field(i).Tag.Lookup("jsonapi")
+                if !ok || tag == "-" {
+                        continue
+                }
+                opts := strings.Split(tag, ",")
+                if len(opts) < 2 {
+                        return nil, fmt.Errorf("jsonapi: bad tag %q on %s.%s", tag, t.Name(), t.Field(i).Name)
+                }
+                f := field{index: i, kind: opts[0], name: opts[1]}
+                for _, opt := range opts[2:] {
+                        f.omitempty = f.omitempty || opt == "omitempty"
+                }
+                switch f.kind {
+                case "primary":
+                        primary = true
+                case "attr", "relation":
+                default:
+                        return nil, fmt.Errorf("jsonapi: bad tag %q on %s.%s", tag, t.Name(), t.Field(i).Name)
+                }
+                fs = append(fs, f)
+        }
+        if !primary {
+                return nil, fmt.Errorf("jsonapi: %s has no primary field", t.Name())
+        }
+        return fs, nil
+}
+
+// marshaler collects the included resources once by type and id.
+type marshaler struct {
+        opts     Options
+        primary  map[Identifier]bool
+        seen     map[Identifier]bool
+        included []*Resource
+}
+
+// Marshal builds the document of v, a tagged struct, a pointer to one
+// or a slice of them, with the related resources requested by opts.
+func Marshal(v interface{}, opts Options) (*Document, error) {
+        m := &marshaler{opts: opts, primary: make(map[Identifier]bool), seen: make(map[Identifier]bool)}
+        val := reflect.ValueOf(v)
+        doc := &Document{}
+        if val.Kind() == reflect.Slice || val.Kind() == reflect.Array {
+                data := make([]*Resource, 0, val.Len())
+                for i := 0; i < val.Len(); i++ {
+                        res, err := m.resource(val.Index(i), "")
+                        if err != nil {
+                                return nil, err
+                        }
+                        if res != nil {
+                                data = append(data, res)
+                        }
+                }
+                doc.Data = data
+        } else {
+                res, err := m.resource(val, "")
+                if err != nil {
+                        return nil, err
+                }
+                if res == nil {
+                        doc.Data = json.RawMessage("null")
+                } else {
+                        doc.Data = res
+                }
+        }
+        // a primary resource is never repeated in included
+        for _, res := range m.included {
+                if !m.primary[Identifier{Type: res.Type, ID: res.ID}] {
+                        doc.Included = append(doc.Included, res)
+                }
+        }
+        return doc, nil
+}
+
+func (m *marshaler) wanted(typ, name string) bool {
+        fs, ok := m.opts.Fields[typ]
+        if !ok {
+                return true
+        }
+        for _, f := range fs {
+                if f == name {
+                        return true
+                }
+        }
+        return false
+}
+
+// includes reports whether the relationship at path is included.
+func (m *marshaler) includes(path string) bool {
+        for _, inc := range m.opts.Include {
+                if inc == path || strings.HasPrefix(inc, path+".") {
+                        return true
+                }
+        }
+        return false
+}
+
+// identify returns the struct of v, its fields and identifier,
+// v is nil when it is a nil pointer.
+func identify(v reflect.Value) (reflect.Value, []field, Identifier, error) {
+        if !v.IsValid() {
+                return v, nil, Identifier{}, errors.New("jsonapi: cannot marshal nil")
+        }
+        for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+                if v.IsNil() {
+                        return reflect.Value{}, nil, Identifier{}, nil
+                }
+                v = v.Elem()
+        }
+        if v.Kind() != reflect.Struct {
+                return v, nil, Identifier{}, fmt.Errorf("jsonapi: cannot marshal %s, want a struct", v.Type())
+        }
+        fs, err := fields(v.Type())
+        if err != nil {
+                return v, nil, Identifier{}, err
+        }
+        id := Identifier{}
+        for _, f := range fs {
+                if f.kind == "primary" {
+                        id.Type, id.ID = f.name, fmt.Sprint(v.Field(f.index).Interface())
+                }
+        }
+        return v, fs, id, nil
+}
+
+// resource builds the resource of v, path is the relationship path
+// of v, empty for a primary resource.
+func (m *marshaler) resource(v reflect.Value, path string) (*Resource, error) {
+        v, fs, id, err := identify(v)
+        if err != nil || !v.IsValid() {
+                return nil, err
+        }
+        res := &Resource{Type: id.Type, ID: id.ID}
+        if path == "" {
+                m.primary[Identifier{Type: res.Type, ID: res.ID}] = true
+        }
+        for _, f := range fs {
+                fv := v.Field(f.index)
+                if f.kind == "primary" || !m.wanted(res.Type, f.name) || (f.omitempty && fv.IsZero()) {
+                        continue
+                }
+                if f.kind == "attr" {
+                        if res.Attributes == nil {
+                                res.Attributes = make(map[string]interface{})
+                        }
+                        res.Attributes[f.name] = fv.Interface()
+                        continue
+                }
+                rel, err := m.relationship(fv, strings.TrimPrefix(path+"."+f.name, "."))
+                if err != nil {
+                        return nil, err
+                }
+                if res.Relationships == nil {
+                        res.Relationships = make(map[string]*Relationship)
+                }
+                res.Relationships[f.name] = rel
+        }
+        return res, nil
+}
+
+func (m *marshaler) relationship(v reflect.Value, path string) (*Relationship, error) {
+        if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+                id, err := m.related(v, path)
+                if err != nil || id == nil {
+                        return &Relationship{}, err
+                }
+                return &Relationship{Data: id}, nil
+        }
+        ids := make([]*Identifier, 0, v.Len())
+        for i := 0; i < v.Len(); i++ {
+                id, err := m.related(v.Index(i), path)
+                if err != nil {
+                        return nil, err
+                }
+                if id != nil {
+                        ids = append(ids, id)
+                }
+        }
+        return &Relationship{Data: ids}, nil
+}
+
+// related returns the identifier of the related resource v,
+// adding it to the included resources when path is included.
+func (m *marshaler) related(v reflect.Value, path string) (*Identifier, error) {
+        v, _, id, err := identify(v)
+        if err != nil || !v.IsValid() {
+                return nil, err
+        }
+        // marked before the resource is built, so a cycle stops here
+        if m.includes(path) && !m.seen[id] {
+                m.seen[id] = true
+                res, err := m.resource(v, path)
+                if err != nil {
+                        return nil, err
+                }
+                m.included = append(m.included, res)
+        }
+        return &id, nil
+}
+
+// PaginationMeta returns the document meta and links of a pagination.
+func PaginationMeta(doc *Document, p suki.Pagination) {
+        if doc.Meta == nil {
+                doc.Meta = make(map[string]interface{})
+        }
+        doc.Meta["page"] = p.Page
+        doc.Meta["size"] = p.Size
+        doc.Meta["total"] = p.Total
+        doc.Meta["total_pages"] = p.TotalPages
+        doc.Links = p.Links
+}
+
+// Write writes v as a JSON:API document with the include and sparse fields
+// of the request, pages are the pagination of a list, at most one is used.
+func Write(w http.ResponseWriter, r *http.Request, v interface{}, pages ...suki.Pagination) {
+        doc, err := Marshal(v, ParseOptions(r))
+        if err != nil {
+                WriteError(w, r, err)
+                return
+        }
+        if len(pages) > 0 {
+                PaginationMeta(doc, pages[0])
+        }
+        status, ok := suki.ResponseStatus(w, r)
+        if !ok {
+                status = http.StatusOK
+        }
+        writeDocument(w, status, doc)
+}
+
+func writeDocument(w http.ResponseWriter, status int, doc *Document) {
+        b, err := json.Marshal(doc)
+        if err != nil {
+                http.Error(w, err.Error(), http.StatusInternalServerError)
+                return
+        }
+        w.Header().Set("Content-Type", ContentType)
+        w.WriteHeader(status)
+        _, _ = w.Write(b)
+}
@@ -0,0 +1,143 @@
+/*  jsonapi_test.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 20, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 24/10/26 11:50
+ */
+
+package jsonapi
+
+import (
+        "encoding/json"
+        "net/http"
+        "net/http/httptest"
+        "net/url"
+        "testing"
+
+        "github.com/stretchr/testify/assert"
+        "github.com/stretchr/testify/require"
+        "gitlab.com/suryakencana007/suki"
+)
+
+type courier struct {
+        ID   string `jsonapi:"primary,couriers"`
+        Name string `jsonapi:"attr,name"`
+}
+
+type group struct {
+        ID       int       `jsonapi:"primary,groups"`
+        Name     string    `jsonapi:"attr,name"`
+        Category string    `jsonapi:"attr,category,omitempty"`
+        Parent   *group    `jsonapi:"relation,parent"`
+        Couriers []courier `jsonapi:"relation,couriers"`
+        Secret   string
+}
+
+func groups() []*group {
+        root := &group{ID: 1, Name: "Jakarta"}
+        south := &group{ID: 2, Name: "Jakarta Selatan", Category: "RG", Parent: root,
+                Couriers: []courier{{ID: "c1", Name: "Budi"}, {ID: "c2", Name: "Sari"}}}
+        return []*group{root, south}
+}
+
+func TestMarshal(t *testing.T) {
+        doc, err := Marshal(groups(), Options{Include: []string{"parent", "couriers"}})
+        require.NoError(t, err)
+        b, _ := json.Marshal(doc)
+        assert.JSONEq(t, `{
+                "data": [
+                        {"type": "groups", "id": "1", "attributes": {"name": "Jakarta"},
+                         "relationships": {"parent": {"data": null}, "couriers": {"data": []}}},
+                        {"type": "groups", "id": "2", "attributes": {"name": "Jakarta Selatan", "category": "RG"},
+                         "relationships": {"parent": {"data": {"type": "groups", "id": "1"}},
+                          "couriers": {"data": [{"type": "couriers", "id": "c1"}, {"type": "couriers", "id": "c2"}]}}}
+                ],
+                "included": [
+                        {"type": "couriers", "id": "c1", "attributes": {"name": "Budi"}},
+                        {"type": "couriers", "id": "c2", "attributes": {"name": "Sari"}}
+                ]
+        }`, string(b))
+}
+
+func TestMarshalSparseFields(t *testing.T) {
+        r := httptest.NewRequest(http.MethodGet, "/groups/2?include=parent&fields[groups]=name,parent", nil)
+        doc, err := Marshal(groups()[1], ParseOptions(r))
+        require.NoError(t, err)
+        b, _ := json.Marshal(doc)
+        assert.JSONEq(t, `{
+                "data": {"type": "groups", "id": "2", "attributes": {"name": "Jakarta Selatan"},
+                        "relationships": {"parent": {"data": {"type": "groups", "id": "1"}}}},
+                "included": [
+                        {"type": "groups", "id": "1", "attributes": {"name": "Jakarta"},
+                         "relationships": {"parent": {"data": null}}}
+                ]
+        }`, string(b))
+}
+
+func TestMarshalCycle(t *testing.T) {
+        type node struct {
+                ID   int   `jsonapi:"primary,nodes"`
+                Next *node `jsonapi:"relation,next"`
+        }
+        a, b := &node{ID: 1}, &node{ID: 2}
+        a.Next, b.Next = b, a
+        doc, err := Marshal(a, Options{Include: []string{"next.next"}})
+        require.NoError(t, err)
+        assert.Len(t, doc.Included, 1)
+
+        _, err = Marshal(struct{ Name string }{"x"}, Options{})
+        assert.Error(t, err)
+}
+
+func TestMarshalNil(t *testing.T) {
+        _, err := Marshal(nil, Options{})
+        assert.Error(t, err)
+
+        doc, err := Marshal((*group)(nil), Options{})
+        require.NoError(t, err)
+        assert.Equal(t, json.RawMessage("null"), doc.Data)
+}
+
+func TestWrite(t *testing.T) {
+        u, _ := url.Parse("/groups?sort=name")
+        r := httptest.NewRequest(http.MethodGet, u.String(), nil)
+        w := httptest.NewRecorder()
+        Write(w, r, []*group{}, suki.NewPagination(u, 1, 20, 0))
+
+        assert.Equal(t, http.StatusOK, w.Code)
+        assert.Equal(t, ContentType, w.Header().Get("Content-Type"))
+        assert.JSONEq(t, `{
+                "data": [],
+                "meta": {"page": 1, "size": 20, "total": 0, "total_pages": 0},
+                "links": {
+                        "self": "/groups?page%5Bnumber%5D=1&page%5Bsize%5D=20&sort=name",
+                        "first": "/groups?page%5Bnumber%5D=1&page%5Bsize%5D=20&sort=name",
+                        "last": "/groups?page%5Bnumber%5D=1&page%5Bsize%5D=20&sort=name"
+                }
+        }`, w.Body.String())
+}
+
+func TestWriteError(t *testing.T) {
+        r := httptest.NewRequest(http.MethodPost, "/groups", nil)
+        w := httptest.NewRecorder()
+        WriteError(w, r, suki.ErrBadRequest.WithDetails(suki.Meta{
+                Code:    "name",
+                Type:    "string",
+                Message: "Invalid Type  for input name",
+        }))
+
+        assert.Equal(t, http.StatusBadRequest, w.Code)
+        assert.JSONEq(t, `{"errors": [
+                {"status": "400", "code": "BAD_REQUEST", "title": "`+suki.StatusText(http.StatusBadRequest)+`",
+                 "detail": "`+suki.StatusText(http.StatusBadRequest)+`"},
+                {"status": "400", "code": "string", "title": "`+suki.StatusText(http.StatusBadRequest)+`",
+                 "detail": "Invalid Type  for input name", "source": {"pointer": "/data/attributes/name"}}
+        ]}`, w.Body.String())
+
+        r = r.WithContext(suki.WithLocale(r.Context(), "id"))
+        w = httptest.NewRecorder()
+        WriteError(w, r, suki.ErrNotFound)
+        assert.Equal(t, http.StatusNotFound, w.Code)
+        assert.Contains(t, w.Body.String(), `"detail":"Sumber daya tidak ditemukan"`)
+}
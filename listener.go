@@ -0,0 +1,265 @@
+/*  listener.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 19, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 24/10/26 09:30
+ */
+
+package suki
+
+import (
+        "fmt"
+        "net"
+        "net/url"
+        "os"
+        "strconv"
+        "strings"
+        "sync"
+        "time"
+)
+
+const (
+        listenFdsStart = 3 // SD_LISTEN_FDS_START
+        envListenPid   = "LISTEN_PID"
+        envListenFds   = "LISTEN_FDS"
+        envListenNames = "LISTEN_FDNAMES"
+        envReexecFds   = "SUKI_LISTEN_FDS"
+        envReadyFd     = "SUKI_READY_FD"
+)
+
+// DefaultSocketMode is the permission of unix domain sockets created by Listen.
+var DefaultSocketMode os.FileMode = 0660
+
+// ReexecTimeout is how long Reexec waits for the new process to be ready.
+var ReexecTimeout = 30 * time.Second
+
+var (
+        inheritedOnce sync.Once
+        inheritedMu   sync.Mutex
+        inherited     []net.Listener
+)
+
+// listenCount returns the number of file descriptors passed to the process,
+// either by systemd socket activation or by a suki re-exec.
+func listenCount(pid int) int {
+        if v := os.Getenv(envReexecFds); v != "" {
+                n, _ := strconv.Atoi(v)
+                return n
+        }
+        if p, err := strconv.Atoi(os.Getenv(envListenPid)); err != nil || p != pid {
+                return 0
+        }
+        n, _ := strconv.Atoi(os.Getenv(envListenFds))
+        return n
+}
+
+// InheritedListeners returns the listeners passed to the process by systemd
+// socket activation (LISTEN_FDS) or by the parent on a graceful re-exec.
+func InheritedListeners() []net.Listener {
+        inheritedOnce.Do(func() {
+                n := listenCount(os.Getpid())
+                for _, env := range []string{envListenPid, envListenFds, envListenNames, envReexecFds} {
+                        _ = os.Unsetenv(env)
+                }
+                for fd := listenFdsStart; fd < listenFdsStart+n; fd++ {
+                        f := os.NewFile(uintptr(fd), fmt.Sprintf("listener-%d", fd))
+                        l, err := net.FileListener(f)
+                        _ = f.Close()
+                        if err != nil {
+                                Warn("inherited file descriptor is not a listener",
+                                        Field("fd", fd),
+                                        Field("error", err),
+                                )
+                                continue
+                        }
+                        inherited = append(inherited, l)
+                }
+        })
+        inheritedMu.Lock()
+        defer inheritedMu.Unlock()
+        return append([]net.Listener(nil), inherited...)
+}
+
+// takeInherited removes and returns the inherited listener bound to addr.
+func takeInherited(network, addr string) net.Listener {
+        InheritedListeners()
+        inheritedMu.Lock()
+        defer inheritedMu.Unlock()
+        for i, l := range inherited {
+                if l.Addr().Network() != network || !sameAddr(network, l.Addr().String(), addr) {
+                        continue
+                }
+                inherited = append(inherited[:i], inherited[i+1:]...)
+                return l
+        }
+        return nil
+}
+
+func sameAddr(network, have, want string) bool {
+        if have == want {
+                return true
+        }
+        if network == "unix" {
+                return false
+        }
+        _, havePort, err := net.SplitHostPort(have)
+        if err != nil {
+                return false
+        }
+        host, wantPort, err := net.SplitHostPort(want)
+        // ":8080" matches an inherited socket bound to any interface
+        return err == nil && host == "" && havePort == wantPort
+}
+
+// Listen announces on the address of addr, reusing an inherited listener when
+// one is bound to it. A "unix" scheme listens on the socket at addr.Path
+// with the given permission, anything else is a tcp address on addr.Host.
+func Listen(addr url.URL, mode os.FileMode) (net.Listener, error) {
+        if addr.Scheme == "unix" {
+                if l := takeInherited("unix", addr.Path); l != nil {
+                        return l, nil
+                }
+                return ListenUnix(addr.Path, mode)
+        }
+        if l := takeInherited("tcp", addr.Host); l != nil {
+                return l, nil
+        }
+        return net.Listen("tcp", addr.Host)
+}
+
+// ListenUnix listens on a unix domain socket, removing a stale socket file
+// left by a previous process and applying mode to the new one.
+func ListenUnix(path string, mode os.FileMode) (net.Listener, error) {
+        if fi, err := os.Stat(path); err == nil && fi.Mode()&os.ModeSocket != 0 {
+                if conn, err := net.Dial("unix", path); err == nil {
+                        _ = conn.Close()
+                        return nil, fmt.Errorf("unix socket %s is already in use", path)
+                }
+                if err := os.Remove(path); err != nil {
+                        return nil, err
+                }
+        }
+        l, err := net.Listen("unix", path)
+        if err != nil {
+                return nil, err
+        }
+        if err := os.Chmod(path, mode); err != nil {
+                _ = l.Close()
+                return nil, err
+        }
+        return l, nil
+}
+
+// listenerFile returns a duplicate file descriptor of the listener
+// that can be handed over to a child process.
+func listenerFile(l net.Listener) (*os.File, error) {
+        switch ln := l.(type) {
+        case *net.TCPListener:
+                return ln.File()
+        case *net.UnixListener:
+                // the new process owns the socket file from now on
+                ln.SetUnlinkOnClose(false)
+                return ln.File()
+        }
+        return nil, fmt.Errorf("listener %T cannot be passed to a new process", l)
+}
+
+// Reexec starts a new instance of the running binary with the same arguments,
+// passing the listeners to it, and waits up to ReexecTimeout for the new
+// process to call NotifyReady. The caller should drain and stop its own
+// servers once Reexec returns without error. A process not ready in time
+// is killed.
+func Reexec(listeners ...net.Listener) (*os.Process, error) {
+        path, err := os.Executable()
+        if err != nil {
+                return nil, err
+        }
+        return reexec(path, os.Args, listeners...)
+}
+
+func reexec(path string, args []string, listeners ...net.Listener) (*os.Process, error) {
+        files := make([]*os.File, 0, len(listeners))
+        defer func() {
+                for _, f := range files {
+                        _ = f.Close()
+                }
+        }()
+        for _, l := range listeners {
+                f, err := listenerFile(l)
+                if err != nil {
+                        return nil, err
+                }
+                files = append(files, f)
+        }
+        ready, notify, err := os.Pipe()
+        if err != nil {
+                return nil, err
+        }
+        defer ready.Close()
+        env := make([]string, 0)
+        for _, kv := range os.Environ() {
+                switch strings.SplitN(kv, "=", 2)[0] {
+                case envListenPid, envListenFds, envListenNames, envReexecFds, envReadyFd:
+                        continue
+                }
+                env = append(env, kv)
+        }
+        env = append(env,
+                fmt.Sprintf("%s=%d", envReexecFds, len(files)),
+                fmt.Sprintf("%s=%d", envReadyFd, listenFdsStart+len(files)),
+        )
+        p, err := os.StartProcess(path, args, &os.ProcAttr{
+                Env:   env,
+                Files: append(append([]*os.File{os.Stdin, os.Stdout, os.Stderr}, files...), notify),
+        })
+        // only the new process holds the write end, its exit closes the pipe
+        _ = notify.Close()
+        if err != nil {
+                return nil, err
+        }
+        if err := waitReady(p, ready, ReexecTimeout); err != nil {
+                _ = p.Kill()
+                _, _ = p.Wait()
+                return nil, err
+        }
+        return p, nil
+}
+
+// waitReady waits for the byte written by NotifyReady in the process p.
+func waitReady(p *os.Process, ready *os.File, timeout time.Duration) error {
+        done := make(chan error, 1)
+        go func() {
+                _, err := ready.Read(make([]byte, 1))
+                done <- err
+        }()
+        timer := time.NewTimer(timeout)
+        defer timer.Stop()
+        select {
+        case err := <-done:
+                if err != nil {
+                        return fmt.Errorf("process %d exited before it was ready", p.Pid)
+                }
+                return nil
+        case <-timer.C:
+                return fmt.Errorf("process %d was not ready after %s", p.Pid, timeout)
+        }
+}
+
+// NotifyReady tells the parent of a graceful re-exec that the process serves
+// its inherited listeners, the parent drains its own connections then. It
+// does nothing in a process not started by Reexec.
+func NotifyReady() {
+        v := os.Getenv(envReadyFd)
+        if v == "" {
+                return
+        }
+        _ = os.Unsetenv(envReadyFd)
+        fd, err := strconv.Atoi(v)
+        if err != nil {
+                return
+        }
+        f := os.NewFile(uintptr(fd), "ready")
+        _, _ = f.Write([]byte{1})
+        _ = f.Close()
+}
@@ -0,0 +1,129 @@
+/*  listener_test.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 19, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 24/10/26 09:40
+ */
+
+package suki
+
+import (
+        "fmt"
+        "io/ioutil"
+        "net"
+        "net/url"
+        "os"
+        "path/filepath"
+        "strconv"
+        "testing"
+        "time"
+
+        "github.com/stretchr/testify/assert"
+        "github.com/stretchr/testify/require"
+)
+
+func TestListenCount(t *testing.T) {
+        defer func() {
+                for _, env := range []string{envListenPid, envListenFds, envReexecFds} {
+                        _ = os.Unsetenv(env)
+                }
+        }()
+        _ = os.Setenv(envListenPid, strconv.Itoa(os.Getpid()))
+        _ = os.Setenv(envListenFds, "2")
+        assert.Equal(t, 2, listenCount(os.Getpid()))
+        assert.Equal(t, 0, listenCount(os.Getpid()+1), "fds belong to another process")
+
+        _ = os.Setenv(envReexecFds, "1")
+        assert.Equal(t, 1, listenCount(os.Getpid()+1))
+}
+
+func TestListenUnix(t *testing.T) {
+        dir, err := ioutil.TempDir("", "suki")
+        require.NoError(t, err)
+        defer os.RemoveAll(dir)
+        path := filepath.Join(dir, "suki.sock")
+
+        l, err := Listen(url.URL{Scheme: "unix", Path: path}, 0600)
+        require.NoError(t, err)
+        fi, err := os.Stat(path)
+        require.NoError(t, err)
+        assert.Equal(t, os.FileMode(0600), fi.Mode().Perm())
+        assert.True(t, fi.Mode()&os.ModeSocket != 0)
+
+        _, err = ListenUnix(path, 0600)
+        assert.Error(t, err, "socket in use")
+
+        // leave a stale socket file behind
+        l.(*net.UnixListener).SetUnlinkOnClose(false)
+        require.NoError(t, l.Close())
+        l, err = ListenUnix(path, 0660)
+        require.NoError(t, err)
+        assert.NoError(t, l.Close())
+}
+
+func TestListenInherited(t *testing.T) {
+        l, err := net.Listen("tcp", "127.0.0.1:0")
+        require.NoError(t, err)
+        defer l.Close()
+        InheritedListeners()
+        inheritedMu.Lock()
+        inherited = append(inherited, l)
+        inheritedMu.Unlock()
+
+        _, port, _ := net.SplitHostPort(l.Addr().String())
+        got, err := Listen(url.URL{Scheme: "http", Host: fmt.Sprintf(":%s", port)}, DefaultSocketMode)
+        require.NoError(t, err)
+        assert.Equal(t, l, got)
+        assert.Empty(t, InheritedListeners())
+}
+
+// TestReexecChild is the process started by TestReexecReady, it does
+// nothing in a plain test run.
+func TestReexecChild(t *testing.T) {
+        switch os.Getenv("SUKI_TEST_REEXEC") {
+        case "ready":
+                if len(InheritedListeners()) != 1 {
+                        os.Exit(2)
+                }
+                NotifyReady()
+                os.Exit(0)
+        case "exit":
+                os.Exit(0)
+        case "hang":
+                time.Sleep(time.Minute)
+                os.Exit(0)
+        }
+        t.Skip("started by TestReexecReady")
+}
+
+func TestReexecReady(t *testing.T) {
+        l, err := net.Listen("tcp", "127.0.0.1:0")
+        require.NoError(t, err)
+        defer l.Close()
+        defer os.Unsetenv("SUKI_TEST_REEXEC")
+        timeout := ReexecTimeout
+        defer func() { ReexecTimeout = timeout }()
+        ReexecTimeout = 10 * time.Second
+        path, err := os.Executable()
+        require.NoError(t, err)
+        args := []string{path, "-test.run=^TestReexecChild$"}
+
+        _ = os.Setenv("SUKI_TEST_REEXEC", "ready")
+        p, err := reexec(path, args, l)
+        require.NoError(t, err)
+        state, err := p.Wait()
+        require.NoError(t, err)
+        assert.True(t, state.Success(), "the new process got the listener")
+
+        _ = os.Setenv("SUKI_TEST_REEXEC", "exit")
+        _, err = reexec(path, args, l)
+        assert.Error(t, err, "exited before it was ready")
+
+        ReexecTimeout = 100 * time.Millisecond
+        _ = os.Setenv("SUKI_TEST_REEXEC", "hang")
+        start := time.Now()
+        _, err = reexec(path, args, l)
+        assert.Error(t, err, "not ready in time")
+        assert.True(t, time.Since(start) < 10*time.Second, "the new process is killed")
+}
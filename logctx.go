@@ -0,0 +1,71 @@
+/*  logctx.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 21, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 21/10/26 14:10
+ */
+
+package suki
+
+import (
+        "context"
+        "crypto/rand"
+        "encoding/hex"
+)
+
+type ctxKeyLogger struct {
+        Name string
+}
+
+func (r *ctxKeyLogger) String() string {
+        return "context value " + r.Name
+}
+
+var (
+        CtxLogger    = ctxKeyLogger{Name: "context logger"}
+        CtxRequestID = ctxKeyLogger{Name: "context request id"}
+)
+
+// WithLogger returns a context carrying the logger l.
+func WithLogger(ctx context.Context, l Logging) context.Context {
+        return context.WithValue(ctx, CtxLogger, l)
+}
+
+// FromContext returns the logger of the context, the process wide
+// logger when the context has none.
+func FromContext(ctx context.Context) Logging {
+        if ctx != nil {
+                if l, ok := ctx.Value(CtxLogger).(Logging); ok && l != nil {
+                        return l
+                }
+        }
+        return Current()
+}
+
+// WithFields returns a context carrying the logger of ctx with the fields,
+// every line logged from FromContext of the new context has them.
+func WithFields(ctx context.Context, fields ...interface{}) context.Context {
+        return WithLogger(ctx, FromContext(ctx).With(fields...))
+}
+
+// WithRequestID returns a context carrying the request id.
+func WithRequestID(ctx context.Context, id string) context.Context {
+        return context.WithValue(ctx, CtxRequestID, id)
+}
+
+// RequestID returns the request id of the context, see ruuto.RequestID
+// to set it for every request of a router.
+func RequestID(ctx context.Context) string {
+        id, _ := ctx.Value(CtxRequestID).(string)
+        return id
+}
+
+// NewRequestID returns a random 128 bits id in hex.
+func NewRequestID() string {
+        b := make([]byte, 16)
+        if _, err := rand.Read(b); err != nil {
+                return ""
+        }
+        return hex.EncodeToString(b)
+}
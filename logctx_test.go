@@ -0,0 +1,65 @@
+/*  logctx_test.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 21, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 21/10/26 14:45
+ */
+
+package suki
+
+import (
+        "context"
+        "fmt"
+        "runtime"
+        "testing"
+
+        "github.com/stretchr/testify/assert"
+)
+
+func TestFromContext(t *testing.T) {
+        assert.Equal(t, Current(), FromContext(context.Background()))
+
+        log, ts := newZap(t)
+        log.noCaller = true
+        ctx := WithLogger(context.Background(), log)
+        assert.Equal(t, log, FromContext(ctx))
+
+        ctx = WithFields(WithRequestID(ctx, "a1b2"), Field("request_id", "a1b2"))
+        FromContext(ctx).Info("received work order", Field("Key", "Key Field"))
+        FromContext(WithFields(ctx, Field("principal", "courier-7"))).Warn("work may fail")
+        log.Info("starting work")
+        ts.AssertMessages(
+                `{"level":"info","msg":"received work order","request_id":"a1b2","Key":"Key Field"}`,
+                `{"level":"warn","msg":"work may fail","request_id":"a1b2","principal":"courier-7"}`,
+                `{"level":"info","msg":"starting work"}`,
+        )
+        assert.Equal(t, "a1b2", RequestID(ctx))
+        assert.Equal(t, "", RequestID(context.Background()))
+}
+
+func TestCallerOfContextLogger(t *testing.T) {
+        old := Current()
+        defer SetLogger(old)
+        log, ts := newZap(t)
+        SetLogger(log)
+        ctx := WithFields(context.Background(), Field("request_id", "a1b2"))
+
+        _, _, line, _ := runtime.Caller(0)
+        Info("package function")
+        log.Info("logger method")
+        FromContext(ctx).Info("context logger")
+        With(Field("order", "INV-7")).Warn("child logger")
+
+        assert.Len(t, ts.Messages, 4)
+        for i, msg := range ts.Messages {
+                assert.Contains(t, msg, fmt.Sprintf(`"caller":"logctx_test.go:%d"`, line+1+i))
+                assert.Contains(t, msg, `"function":"TestCallerOfContextLogger"`)
+        }
+}
+
+func TestNewRequestID(t *testing.T) {
+        id := NewRequestID()
+        assert.Len(t, id, 32)
+        assert.NotEqual(t, id, NewRequestID())
+}
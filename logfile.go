@@ -0,0 +1,315 @@
+/*  logfile.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 21, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 21/10/26 18:20
+ */
+
+package suki
+
+import (
+        "compress/gzip"
+        "errors"
+        "io"
+        "io/ioutil"
+        "os"
+        "os/signal"
+        "path/filepath"
+        "sort"
+        "strings"
+        "sync"
+        "time"
+)
+
+// backupTimeFormat is the time of the rotation in the name of a backup,
+// app.log is rotated to app-2026-10-21T18-20-00.000.log.
+const backupTimeFormat = "2006-01-02T15-04-05.000"
+
+type RotateOptions struct {
+        Filename   string        // file to write, created with its directory
+        MaxSize    int64         // rotate before the file grows over MaxSize bytes, 0 for no limit
+        Interval   time.Duration // rotate the file Interval after it is opened, 0 for never
+        MaxBackups int           // backups to keep, 0 keeps them all
+        MaxAge     time.Duration // remove the backups older than MaxAge, 0 keeps them all
+        Compress   bool          // gzip the backups
+        LocalTime  bool          // local time in the backup names, UTC by default
+}
+
+// RotatingFile is a zapcore.WriteSyncer writing to a file rotated by size
+// or time. It reopens the file on SIGHUP, so a file moved by logrotate is
+// created again. Tee it with the default outputs through Options.Tee.
+type RotatingFile struct {
+        opts RotateOptions
+
+        mu       sync.Mutex
+        file     *os.File
+        size     int64
+        openedAt time.Time
+        now      func() time.Time
+
+        millc   chan struct{}
+        signals chan os.Signal
+        done    chan struct{}
+        wg      sync.WaitGroup
+}
+
+// NewRotatingFile opens, or creates, the file of the options.
+func NewRotatingFile(opts RotateOptions) (*RotatingFile, error) {
+        if opts.Filename == "" {
+                return nil, errors.New("suki: the rotating file has no name")
+        }
+        f := &RotatingFile{
+                opts:  opts,
+                now:   time.Now,
+                millc: make(chan struct{}, 1),
+                done:  make(chan struct{}),
+        }
+        if err := f.open(); err != nil {
+                return nil, err
+        }
+        f.wg.Add(1)
+        go f.mill()
+        if len(reopenSignals) > 0 {
+                f.signals = make(chan os.Signal, 1)
+                signal.Notify(f.signals, reopenSignals...)
+                f.wg.Add(1)
+                go f.watch()
+        }
+        return f, nil
+}
+
+func (f *RotatingFile) Write(p []byte) (int, error) {
+        f.mu.Lock()
+        defer f.mu.Unlock()
+        if f.file == nil {
+                return 0, os.ErrClosed
+        }
+        if f.due(int64(len(p))) {
+                if err := f.rotate(); err != nil {
+                        return 0, err
+                }
+        }
+        n, err := f.file.Write(p)
+        f.size += int64(n)
+        return n, err
+}
+
+func (f *RotatingFile) Sync() error {
+        f.mu.Lock()
+        defer f.mu.Unlock()
+        if f.file == nil {
+                return nil
+        }
+        return f.file.Sync()
+}
+
+// Rotate moves the file to a backup and opens a new one.
+func (f *RotatingFile) Rotate() error {
+        f.mu.Lock()
+        defer f.mu.Unlock()
+        if f.file == nil {
+                return os.ErrClosed
+        }
+        return f.rotate()
+}
+
+// Reopen closes the file and opens its name again.
+func (f *RotatingFile) Reopen() error {
+        f.mu.Lock()
+        defer f.mu.Unlock()
+        if f.file == nil {
+                return os.ErrClosed
+        }
+        if err := f.file.Close(); err != nil {
+                return err
+        }
+        return f.open()
+}
+
+// Close closes the file once the backups are compressed and removed.
+func (f *RotatingFile) Close() error {
+        f.mu.Lock()
+        if f.file == nil {
+                f.mu.Unlock()
+                return nil
+        }
+        err := f.file.Close()
+        f.file = nil
+        f.mu.Unlock()
+        if f.signals != nil {
+                signal.Stop(f.signals)
+        }
+        close(f.done)
+        f.wg.Wait()
+        return err
+}
+
+func (f *RotatingFile) due(n int64) bool {
+        if f.opts.MaxSize > 0 && f.size > 0 && f.size+n > f.opts.MaxSize {
+                return true
+        }
+        return f.opts.Interval > 0 && f.now().Sub(f.openedAt) >= f.opts.Interval
+}
+
+func (f *RotatingFile) open() error {
+        if err := os.MkdirAll(filepath.Dir(f.opts.Filename), 0755); err != nil {
+                return err
+        }
+        file, err := os.OpenFile(f.opts.Filename, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+        if err != nil {
+                return err
+        }
+        info, err := file.Stat()
+        if err != nil {
+                _ = file.Close()
+                return err
+        }
+        f.file, f.size, f.openedAt = file, info.Size(), f.now()
+        return nil
+}
+
+func (f *RotatingFile) rotate() error {
+        if err := f.file.Close(); err != nil {
+                return err
+        }
+        if err := os.Rename(f.opts.Filename, f.backupName(f.now())); err != nil && !os.IsNotExist(err) {
+                return err
+        }
+        if err := f.open(); err != nil {
+                return err
+        }
+        select {
+        case f.millc <- struct{}{}:
+        default:
+        }
+        return nil
+}
+
+func (f *RotatingFile) backupName(t time.Time) string {
+        if !f.opts.LocalTime {
+                t = t.UTC()
+        }
+        ext := filepath.Ext(f.opts.Filename)
+        return strings.TrimSuffix(f.opts.Filename, ext) + "-" + t.Format(backupTimeFormat) + ext
+}
+
+func (f *RotatingFile) watch() {
+        defer f.wg.Done()
+        for {
+                select {
+                case <-f.signals:
+                        if err := f.Reopen(); err != nil && err != os.ErrClosed {
+                                Error("reopen log file failed", Field("file", f.opts.Filename), Field("error", err))
+                        }
+                case <-f.done:
+                        return
+                }
+        }
+}
+
+// mill compresses and removes the backups out of the request path.
+func (f *RotatingFile) mill() {
+        defer f.wg.Done()
+        for {
+                select {
+                case <-f.millc:
+                        f.millBackups()
+                case <-f.done:
+                        select {
+                        case <-f.millc:
+                                f.millBackups()
+                        default:
+                        }
+                        return
+                }
+        }
+}
+
+type backup struct {
+        path string
+        at   time.Time
+}
+
+// backups returns the backups of the file, the newest first.
+func (f *RotatingFile) backups() ([]backup, error) {
+        dir := filepath.Dir(f.opts.Filename)
+        ext := filepath.Ext(f.opts.Filename)
+        prefix := strings.TrimSuffix(filepath.Base(f.opts.Filename), ext) + "-"
+        infos, err := ioutil.ReadDir(dir)
+        if err != nil {
+                return nil, err
+        }
+        location := time.UTC
+        if f.opts.LocalTime {
+                location = time.Local
+        }
+        backups := make([]backup, 0)
+        for _, info := range infos {
+                name := strings.TrimSuffix(info.Name(), ".gz")
+                if info.IsDir() || !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, ext) {
+                        continue
+                }
+                at, err := time.ParseInLocation(backupTimeFormat, name[len(prefix):len(name)-len(ext)], location)
+                if err != nil {
+                        continue
+                }
+                backups = append(backups, backup{path: filepath.Join(dir, info.Name()), at: at})
+        }
+        sort.Slice(backups, func(i, j int) bool {
+                return backups[i].at.After(backups[j].at)
+        })
+        return backups, nil
+}
+
+func (f *RotatingFile) millBackups() {
+        backups, err := f.backups()
+        if err != nil {
+                Error("list log backups failed", Field("file", f.opts.Filename), Field("error", err))
+                return
+        }
+        for i, b := range backups {
+                expired := f.opts.MaxAge > 0 && f.now().Sub(b.at) > f.opts.MaxAge
+                if (f.opts.MaxBackups > 0 && i >= f.opts.MaxBackups) || expired {
+                        _ = os.Remove(b.path)
+                        continue
+                }
+                if f.opts.Compress && !strings.HasSuffix(b.path, ".gz") {
+                        if err := compressFile(b.path); err != nil {
+                                Error("compress log backup failed", Field("file", b.path), Field("error", err))
+                        }
+                }
+        }
+}
+
+// compressFile replaces the file by its gzip, file.gz.
+func compressFile(name string) (err error) {
+        src, err := os.Open(name)
+        if err != nil {
+                return err
+        }
+        defer src.Close()
+        dst, err := os.OpenFile(name+".gz", os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+        if err != nil {
+                return err
+        }
+        defer func() {
+                if err != nil {
+                        _ = os.Remove(name + ".gz")
+                }
+        }()
+        gz := gzip.NewWriter(dst)
+        if _, err = io.Copy(gz, src); err != nil {
+                _ = dst.Close()
+                return err
+        }
+        if err = gz.Close(); err != nil {
+                _ = dst.Close()
+                return err
+        }
+        if err = dst.Close(); err != nil {
+                return err
+        }
+        _ = src.Close()
+        return os.Remove(name)
+}
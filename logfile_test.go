@@ -0,0 +1,136 @@
+/*  logfile_test.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 21, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 21/10/26 18:50
+ */
+
+package suki
+
+import (
+        "compress/gzip"
+        "io/ioutil"
+        "os"
+        "path/filepath"
+        "sort"
+        "strings"
+        "testing"
+        "time"
+
+        "github.com/stretchr/testify/assert"
+        "github.com/stretchr/testify/require"
+        "go.uber.org/zap/zapcore"
+)
+
+func logDir(t *testing.T) string {
+        dir, err := ioutil.TempDir("", "suki-log")
+        require.NoError(t, err)
+        return dir
+}
+
+func logFiles(t *testing.T, dir string) []string {
+        infos, err := ioutil.ReadDir(dir)
+        require.NoError(t, err)
+        names := make([]string, 0)
+        for _, info := range infos {
+                names = append(names, info.Name())
+        }
+        sort.Strings(names)
+        return names
+}
+
+func TestRotatingFileSize(t *testing.T) {
+        dir := logDir(t)
+        defer os.RemoveAll(dir)
+
+        f, err := NewRotatingFile(RotateOptions{Filename: filepath.Join(dir, "app.log"), MaxSize: 10, MaxBackups: 2})
+        require.NoError(t, err)
+        clock := time.Date(2026, 10, 21, 18, 0, 0, 0, time.UTC)
+        f.now = func() time.Time { return clock }
+        for _, line := range []string{"order 1\n", "order 2\n", "order 3\n", "order 4\n"} {
+                clock = clock.Add(time.Minute)
+                _, err := f.Write([]byte(line))
+                assert.NoError(t, err)
+        }
+        require.NoError(t, f.Close())
+
+        assert.Equal(t, []string{
+                "app-2026-10-21T18-03-00.000.log",
+                "app-2026-10-21T18-04-00.000.log",
+                "app.log",
+        }, logFiles(t, dir))
+        b, _ := ioutil.ReadFile(filepath.Join(dir, "app.log"))
+        assert.Equal(t, "order 4\n", string(b))
+        b, _ = ioutil.ReadFile(filepath.Join(dir, "app-2026-10-21T18-04-00.000.log"))
+        assert.Equal(t, "order 3\n", string(b))
+
+        _, err = f.Write([]byte("late"))
+        assert.Equal(t, os.ErrClosed, err)
+}
+
+func TestRotatingFileIntervalCompress(t *testing.T) {
+        dir := logDir(t)
+        defer os.RemoveAll(dir)
+
+        clock := time.Date(2026, 10, 21, 18, 0, 0, 0, time.UTC)
+        f, err := NewRotatingFile(RotateOptions{
+                Filename: filepath.Join(dir, "app.log"),
+                Interval: time.Hour,
+                MaxAge:   36 * time.Hour,
+                Compress: true,
+        })
+        require.NoError(t, err)
+        f.now = func() time.Time { return clock }
+        f.openedAt = clock
+        // an expired backup of an earlier run
+        require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "app-2026-10-19T18-00-00.000.log.gz"), nil, 0644))
+
+        _, _ = f.Write([]byte("received work order\n"))
+        clock = clock.Add(time.Hour)
+        _, _ = f.Write([]byte("work failed\n"))
+        require.NoError(t, f.Close())
+
+        assert.Equal(t, []string{"app-2026-10-21T19-00-00.000.log.gz", "app.log"}, logFiles(t, dir))
+        gz, err := os.Open(filepath.Join(dir, "app-2026-10-21T19-00-00.000.log.gz"))
+        require.NoError(t, err)
+        defer gz.Close()
+        r, err := gzip.NewReader(gz)
+        require.NoError(t, err)
+        b, _ := ioutil.ReadAll(r)
+        assert.Equal(t, "received work order\n", string(b))
+}
+
+func TestRotatingFileReopen(t *testing.T) {
+        dir := logDir(t)
+        defer os.RemoveAll(dir)
+
+        name := filepath.Join(dir, "app.log")
+        f, err := NewRotatingFile(RotateOptions{Filename: name})
+        require.NoError(t, err)
+        defer f.Close()
+        _, _ = f.Write([]byte("received work order\n"))
+        // logrotate moves the file then signals the process
+        require.NoError(t, os.Rename(name, name+".1"))
+        require.NoError(t, f.Reopen())
+        _, _ = f.Write([]byte("work failed\n"))
+
+        b, _ := ioutil.ReadFile(name)
+        assert.Equal(t, "work failed\n", string(b))
+        b, _ = ioutil.ReadFile(name + ".1")
+        assert.Equal(t, "received work order\n", string(b))
+}
+
+func TestRotatingFileTee(t *testing.T) {
+        dir := logDir(t)
+        defer os.RemoveAll(dir)
+
+        f, err := NewRotatingFile(RotateOptions{Filename: filepath.Join(dir, "app.log")})
+        require.NoError(t, err)
+        l := newZapLog(Options{Encoding: "json", Tee: []zapcore.WriteSyncer{f}, DisableCaller: true})
+        l.Debug("starting work")
+        require.NoError(t, f.Close())
+
+        b, _ := ioutil.ReadFile(filepath.Join(dir, "app.log"))
+        assert.Equal(t, `{"level":"debug","msg":"starting work"}`, strings.TrimSpace(string(b)))
+}
@@ -0,0 +1,20 @@
+//go:build !windows
+// +build !windows
+
+/*  logfile_unix.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 21, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 21/10/26 18:20
+ */
+
+package suki
+
+import (
+        "os"
+        "syscall"
+)
+
+// reopenSignals reopen the rotating files, as logrotate expects.
+var reopenSignals = []os.Signal{syscall.SIGHUP}
@@ -0,0 +1,17 @@
+//go:build windows
+// +build windows
+
+/*  logfile_windows.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 21, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 21/10/26 18:20
+ */
+
+package suki
+
+import "os"
+
+// reopenSignals is empty, there is no SIGHUP on windows.
+var reopenSignals []os.Signal
@@ -3,11 +3,17 @@
 * @Author:             Nanang Suryadi
 * @Date:               November 21, 2019
 * @Last Modified by:   @suryakencana007
-* @Last Modified time: 21/11/19 07:30
+* @Last Modified time: 24/10/26 12:25
  */
 
 package suki
 
+import (
+        "sync"
+
+        "go.uber.org/zap/zapcore"
+)
+
 type Logging interface {
         With(fields ...interface{}) Logging
         Debug(msg string, fields ...interface{})
@@ -20,41 +26,99 @@ type Logging interface {
 }
 
 var (
-        logger *zapLog
+        loggerMu   sync.RWMutex
+        logger     Logging
+        zapDefault *zapLog // Instance when logger is not a zapLog
 )
 
+// Current returns the process wide logger used by the package functions,
+// a zap logger over ProductionCore, or the encoding of SUKI_LOG_ENCODING,
+// until SetLogger or Configure replaces it.
+func Current() Logging {
+        loggerMu.RLock()
+        l := logger
+        loggerMu.RUnlock()
+        if l != nil {
+                return l
+        }
+        loggerMu.Lock()
+        defer loggerMu.Unlock()
+        if logger == nil {
+                logger = newZapLog(Options{})
+        }
+        return logger
+}
+
+// Instance returns the process wide zap logger, the one of Current unless
+// SetLogger installed a Logging of another kind, such as FromSlog, then
+// it is a zap logger over ProductionCore.
 func Instance() *zapLog {
-        return NewZap(ProductionCore())
+        if z, ok := Current().(*zapLog); ok {
+                return z
+        }
+        loggerMu.Lock()
+        defer loggerMu.Unlock()
+        if zapDefault == nil {
+                zapDefault = newZapLog(Options{})
+        }
+        return zapDefault
+}
+
+// SetLogger replaces the process wide logger used by the package functions.
+func SetLogger(l Logging) {
+        loggerMu.Lock()
+        defer loggerMu.Unlock()
+        logger = l
 }
 
 func With(fields ...interface{}) Logging {
-        return Instance().With(fields...)
+        return Current().With(fields...)
 }
 
 func Field(key string, value interface{}) interface{} {
-        return Instance().Field(key, value)
+        return Current().Field(key, value)
 }
 
 func Debug(msg string, fields ...interface{}) {
-        Instance().Debug(msg, fields...)
+        logSkip(Current(), zapcore.DebugLevel, 1, msg, fields)
 }
 
 func Info(msg string, fields ...interface{}) {
-        Instance().Info(msg, fields...)
+        logSkip(Current(), zapcore.InfoLevel, 1, msg, fields)
 }
 
 func Warn(msg string, fields ...interface{}) {
-        Instance().Warn(msg, fields...)
+        logSkip(Current(), zapcore.WarnLevel, 1, msg, fields)
 }
 
 func Error(msg string, fields ...interface{}) {
-        Instance().Error(msg, fields...)
+        logSkip(Current(), zapcore.ErrorLevel, 1, msg, fields)
 }
 
 func Fatal(msg string, fields ...interface{}) {
-        Instance().Fatal(msg, fields...)
+        logSkip(Current(), zapcore.FatalLevel, 1, msg, fields)
 }
 
 func Panic(msg string, fields ...interface{}) {
-        Instance().Panic(msg, fields...)
+        logSkip(Current(), zapcore.PanicLevel, 1, msg, fields)
+}
+
+// logSkip logs with l for the caller skip frames above the function calling
+// it, a Logging which is not a callerLogger reports its own caller.
+func logSkip(l Logging, lvl zapcore.Level, skip int, msg string, fields []interface{}) {
+        if cl, ok := l.(callerLogger); ok {
+                cl.logSkip(lvl, skip+1, msg, fields)
+                return
+        }
+        logAt(l, lvl, msg, fields...)
+}
+
+// logPC logs with l for the log line of the program counter pc, a Logging
+// which is not a callerLogger reports its own caller.
+func logPC(l Logging, lvl zapcore.Level, pc uintptr, msg string, fields []interface{}) {
+        if cl, ok := l.(callerLogger); ok {
+                cl.logPC(lvl, pc, msg, fields)
+                return
+        }
+        logAt(l, lvl, msg, fields...)
 }
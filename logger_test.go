@@ -3,7 +3,7 @@
 * @Author:             Nanang Suryadi
 * @Date:               November 21, 2019
 * @Last Modified by:   @suryakencana007
-* @Last Modified time: 21/11/19 08:12
+* @Last Modified time: 24/10/26 12:30
  */
 
 package suki
@@ -11,16 +11,20 @@ package suki
 import (
         "bytes"
         "fmt"
+        "io/ioutil"
+        "log/slog"
         "strings"
         "testing"
 
         "github.com/go-stack/stack"
         "github.com/stretchr/testify/assert"
+        "go.uber.org/zap"
+        "go.uber.org/zap/zapcore"
 )
 
 func LoggerStackCaller(line int, f string) string {
         strGoStack := "%n"
-        stack := stack.Caller(2)
+        stack := stack.Caller(1)
         return strings.Join([]string{
                 fmt.Sprintf(`"caller":"%s:%d"`, stack, line),
                 fmt.Sprintf(`"function":"%s%s"`, fmt.Sprintf(strGoStack, stack), f),
@@ -39,10 +43,10 @@ func TestZapLogger(t *testing.T) {
         }, "log.Panic should panic")
 
         ts.AssertMessages(
-                `{"level":"info","msg":"received work order","Key":"Key Field",`+LoggerStackCaller(827, "")+`}`,
-                `{"level":"debug","msg":"starting work","Key":"Key Field",`+LoggerStackCaller(827, "")+`}`,
-                `{"level":"warn","msg":"work may fail","Key":"Key Field",`+LoggerStackCaller(827, "")+`}`,
-                `{"level":"error","msg":"work failed","Key":"Key Field",`+LoggerStackCaller(827, "")+`}`,
+                `{"level":"info","msg":"received work order","Key":"Key Field",`+LoggerStackCaller(36, "")+`}`,
+                `{"level":"debug","msg":"starting work","Key":"Key Field",`+LoggerStackCaller(37, "")+`}`,
+                `{"level":"warn","msg":"work may fail","Key":"Key Field",`+LoggerStackCaller(38, "")+`}`,
+                `{"level":"error","msg":"work failed","Key":"Key Field",`+LoggerStackCaller(39, "")+`}`,
         )
 }
 
@@ -57,6 +61,72 @@ func TestLogger(t *testing.T) {
         }, "log.Panic should panic")
 }
 
+func TestConfigure(t *testing.T) {
+        old, lvl := Current(), Level().Level()
+        defer func() {
+                SetLogger(old)
+                Level().SetLevel(lvl)
+        }()
+
+        var buf bytes.Buffer
+        assert.NoError(t, Configure(Options{
+                Level:         "warn",
+                Outputs:       []zapcore.WriteSyncer{zapcore.AddSync(&buf)},
+                DisableCaller: true,
+        }))
+        Info("received work order")
+        With(Field("Key", "Key Field")).Warn("work may fail")
+        assert.Equal(t, `{"level":"warn","msg":"work may fail","Key":"Key Field"}`+"\n", buf.String())
+
+        assert.Error(t, Configure(Options{Level: "loud"}))
+        assert.Error(t, Configure(Options{Encoding: "xml"}))
+}
+
+func TestInstance(t *testing.T) {
+        old := Current()
+        defer SetLogger(old)
+
+        z := NewZap(DefaultCore(zapcore.AddSync(ioutil.Discard)))
+        SetLogger(z)
+        assert.Equal(t, z, Instance())
+        assert.NotNil(t, Instance().Validator(), "the zap logger of the instance")
+
+        sl := FromSlog(slog.New(slog.NewTextHandler(ioutil.Discard, nil)))
+        SetLogger(sl)
+        assert.Equal(t, sl, Current())
+        assert.NotNil(t, Instance().Validator(), "a zap logger beside another Logging")
+}
+
+func TestWithKeepsParent(t *testing.T) {
+        log, ts := newZap(t)
+        log.noCaller = true
+        child := log.With(Field("Key", "Key Field"))
+        child.Info("received work order")
+        log.Info("starting work")
+        ts.AssertMessages(
+                `{"level":"info","msg":"received work order","Key":"Key Field"}`,
+                `{"level":"info","msg":"starting work"}`,
+        )
+}
+
+func BenchmarkInstance(b *testing.B) {
+        old := Current()
+        defer SetLogger(old)
+        SetLogger(NewZap(DefaultCore(zapcore.AddSync(ioutil.Discard))))
+        b.ReportAllocs()
+        for i := 0; i < b.N; i++ {
+                Info("received work order", Field("Key", "Key Field"))
+        }
+}
+
+func BenchmarkNewZapPerCall(b *testing.B) {
+        b.ReportAllocs()
+        for i := 0; i < b.N; i++ {
+                l := &zapLog{logger: zap.New(DefaultCore(zapcore.AddSync(ioutil.Discard)))}
+                l.Info("received work order", Field("Key", "Key Field"))
+        }
+}
+
 // testLogSpy is a testing.TB that captures logged messages.
 type testLogSpy struct {
         testing.TB
@@ -0,0 +1,91 @@
+/*  pagination.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 20, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 20/10/26 16:05
+ */
+
+package suki
+
+import (
+        "fmt"
+        "net/http"
+        "net/url"
+        "strconv"
+        "strings"
+)
+
+const (
+        PageNumberParam = "page[number]"
+        PageSizeParam   = "page[size]"
+)
+
+// Links are the navigation links of a paginated list.
+type Links struct {
+        Self  string `json:"self,omitempty" xml:"self,omitempty"`
+        First string `json:"first,omitempty" xml:"first,omitempty"`
+        Prev  string `json:"prev,omitempty" xml:"prev,omitempty"`
+        Next  string `json:"next,omitempty" xml:"next,omitempty"`
+        Last  string `json:"last,omitempty" xml:"last,omitempty"`
+}
+
+// NewPagination returns the pagination of a page of size items out of total,
+// with the total pages and the links to the other pages built from u. Every
+// query parameter of u, such as filters[...], sort and q, is kept in the links.
+func NewPagination(u *url.URL, page, size, total int) Pagination {
+        if page < 1 {
+                page = 1
+        }
+        p := Pagination{Page: page, Size: size, Total: total}
+        if size > 0 {
+                p.TotalPages = (total + size - 1) / size
+        }
+        last := p.TotalPages
+        if last < 1 {
+                last = 1
+        }
+        link := func(n int) string {
+                q := u.Query()
+                q.Set(PageNumberParam, strconv.Itoa(n))
+                q.Set(PageSizeParam, strconv.Itoa(size))
+                l := *u
+                l.RawQuery = q.Encode()
+                return l.String()
+        }
+        p.Links = &Links{
+                Self:  link(page),
+                First: link(1),
+                Last:  link(last),
+        }
+        if page > 1 {
+                p.Links.Prev = link(page - 1)
+        }
+        if page < last {
+                p.Links.Next = link(page + 1)
+        }
+        return p
+}
+
+// SetPaginationHeaders sets the RFC 8288 Link header from the links
+// of the pagination and X-Total-Count to the total of items.
+func SetPaginationHeaders(w http.ResponseWriter, p Pagination) {
+        w.Header().Set("X-Total-Count", strconv.Itoa(p.Total))
+        if p.Links == nil {
+                return
+        }
+        links := make([]string, 0, 4)
+        for _, l := range []struct{ rel, href string }{
+                {"first", p.Links.First},
+                {"prev", p.Links.Prev},
+                {"next", p.Links.Next},
+                {"last", p.Links.Last},
+        } {
+                if l.href != "" {
+                        links = append(links, fmt.Sprintf(`<%s>; rel="%s"`, l.href, l.rel))
+                }
+        }
+        if len(links) > 0 {
+                w.Header().Set("Link", strings.Join(links, ", "))
+        }
+}
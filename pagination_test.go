@@ -0,0 +1,63 @@
+/*  pagination_test.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 20, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 20/10/26 16:30
+ */
+
+package suki
+
+import (
+        "encoding/json"
+        "net/http/httptest"
+        "net/url"
+        "testing"
+
+        "github.com/stretchr/testify/assert"
+)
+
+func TestNewPagination(t *testing.T) {
+        u, _ := url.Parse("/groups?filters[category:eq]=RG&sort=-name&q=jak&page[number]=2&page[size]=10")
+        p := NewPagination(u, 2, 10, 45)
+
+        assert.Equal(t, 5, p.TotalPages)
+        link := func(page string) string {
+                return "/groups?filters%5Bcategory%3Aeq%5D=RG&page%5Bnumber%5D=" + page + "&page%5Bsize%5D=10&q=jak&sort=-name"
+        }
+        assert.Equal(t, &Links{
+                Self:  link("2"),
+                First: link("1"),
+                Prev:  link("1"),
+                Next:  link("3"),
+                Last:  link("5"),
+        }, p.Links)
+
+        last := NewPagination(u, 5, 10, 45)
+        assert.Empty(t, last.Links.Next)
+        assert.NotEmpty(t, last.Links.Prev)
+
+        empty := NewPagination(u, 1, 10, 0)
+        assert.Equal(t, 0, empty.TotalPages)
+        assert.Empty(t, empty.Links.Prev)
+        assert.Empty(t, empty.Links.Next)
+        assert.Equal(t, empty.Links.First, empty.Links.Last)
+}
+
+func TestSetPaginationHeaders(t *testing.T) {
+        u, _ := url.Parse("/groups?sort=name")
+        p := NewPagination(u, 1, 20, 41)
+        w := httptest.NewRecorder()
+        SetPaginationHeaders(w, p)
+
+        assert.Equal(t, "41", w.Header().Get("X-Total-Count"))
+        assert.Equal(t,
+                `</groups?page%5Bnumber%5D=1&page%5Bsize%5D=20&sort=name>; rel="first", `+
+                        `</groups?page%5Bnumber%5D=2&page%5Bsize%5D=20&sort=name>; rel="next", `+
+                        `</groups?page%5Bnumber%5D=3&page%5Bsize%5D=20&sort=name>; rel="last"`,
+                w.Header().Get("Link"),
+        )
+
+        b, _ := json.Marshal(Pagination{Page: 1, Size: 20, Total: 41})
+        assert.JSONEq(t, `{"page":1,"size":20,"total":41}`, string(b))
+}
@@ -0,0 +1,129 @@
+/*  problem.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 19, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 19/10/26 14:20
+ */
+
+package suki
+
+import (
+        "bytes"
+        "context"
+        "encoding/json"
+        "net/http"
+        "strings"
+)
+
+const ContentTypeProblem = "application/problem+json"
+
+type ctxKeyProblem struct {
+        Name string
+}
+
+func (r *ctxKeyProblem) String() string {
+        return "context value " + r.Name
+}
+
+var CtxProblem = ctxKeyProblem{Name: "context problem"}
+
+// ProblemTypeURI is prefixed to the lower case error code to build the
+// problem type, when empty the type is "about:blank".
+var ProblemTypeURI = ""
+
+// Problem is a RFC 7807 problem details object.
+type Problem struct {
+        Type       string
+        Title      string
+        Status     int
+        Detail     string
+        Instance   string
+        Errors     []Meta                 // validation errors, rendered as the "errors" member
+        Extensions map[string]interface{} // additional members
+}
+
+// MarshalJSON writes the standard members next to the extension members.
+func (p Problem) MarshalJSON() ([]byte, error) {
+        m := make(map[string]interface{}, len(p.Extensions)+6)
+        for k, v := range p.Extensions {
+                m[k] = v
+        }
+        m["type"] = p.Type
+        if p.Type == "" {
+                m["type"] = "about:blank"
+        }
+        m["title"] = p.Title
+        m["status"] = p.Status
+        if p.Detail != "" {
+                m["detail"] = p.Detail
+        }
+        if p.Instance != "" {
+                m["instance"] = p.Instance
+        }
+        if len(p.Errors) > 0 {
+                m["errors"] = p.Errors
+        }
+        return json.Marshal(m)
+}
+
+// NewProblem creates a problem of the status for the request.
+func NewProblem(r *http.Request, status int, detail string) *Problem {
+        return &Problem{
+                Title:    StatusTextLocale(Locale(r.Context()), status),
+                Status:   status,
+                Detail:   detail,
+                Instance: r.URL.RequestURI(),
+        }
+}
+
+// ProblemFromMeta creates a problem from the meta errors, such as
+// the result of Validate.
+func ProblemFromMeta(r *http.Request, status int, errs ...Meta) *Problem {
+        p := NewProblem(r, status, "")
+        p.Errors = errs
+        return p
+}
+
+// ProblemFromError creates a problem from err as WriteError would render it.
+func ProblemFromError(r *http.Request, err error) *Problem {
+        e := AsAppError(err).Localize(Locale(r.Context()))
+        p := NewProblem(r, e.Status, e.Message)
+        if ProblemTypeURI != "" {
+                p.Type = ProblemTypeURI + strings.ToLower(e.Code)
+        }
+        p.Errors = e.Details
+        p.Extensions = map[string]interface{}{"code": e.Code}
+        return p
+}
+
+// WithProblem returns a context selecting problem details for error responses,
+// see ruuto.ProblemDetails to select it for every route of a router.
+func WithProblem(ctx context.Context) context.Context {
+        return context.WithValue(ctx, CtxProblem, true)
+}
+
+// WantsProblem reports whether error responses of the request are written as
+// problem details, selected by the context or by the Accept header.
+func WantsProblem(r *http.Request) bool {
+        if ok, _ := r.Context().Value(CtxProblem).(bool); ok {
+                return true
+        }
+        return strings.Contains(r.Header.Get("Accept"), ContentTypeProblem)
+}
+
+// WriteProblem writes the problem as application/problem+json.
+func WriteProblem(w http.ResponseWriter, r *http.Request, p *Problem) {
+        buf := &bytes.Buffer{}
+        enc := json.NewEncoder(buf)
+        enc.SetEscapeHTML(true)
+        if err := enc.Encode(p); err != nil {
+                http.Error(w, err.Error(), http.StatusInternalServerError)
+                return
+        }
+        w.Header().Set("Content-Type", ContentTypeProblem)
+        w.WriteHeader(p.Status)
+        if _, err := w.Write(buf.Bytes()); err != nil {
+                http.Error(w, err.Error(), http.StatusInternalServerError)
+        }
+}
@@ -0,0 +1,83 @@
+/*  problem_test.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 19, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 19/10/26 14:55
+ */
+
+package suki
+
+import (
+        "encoding/json"
+        "net/http"
+        "net/http/httptest"
+        "testing"
+
+        "github.com/stretchr/testify/assert"
+        "github.com/stretchr/testify/require"
+)
+
+func TestWriteErrorProblem(t *testing.T) {
+        r := httptest.NewRequest(http.MethodGet, "/users/17", nil)
+        r.Header.Set("Accept", "application/problem+json, application/json")
+        w := httptest.NewRecorder()
+        WriteError(w, r, ErrNotFound.WithMessage("user 17 not found"))
+
+        assert.Equal(t, StatusNotFound, w.Code)
+        assert.Equal(t, ContentTypeProblem, w.Header().Get("Content-Type"))
+        assert.JSONEq(t, `{
+                "type": "about:blank",
+                "title": "Resource not found",
+                "status": 404,
+                "detail": "user 17 not found",
+                "instance": "/users/17",
+                "code": "NOT_FOUND"
+        }`, w.Body.String())
+}
+
+func TestWriteJSONProblem(t *testing.T) {
+        dto := DataTransferObject{
+                Email:     "nanang.jobs@gmail",
+                Password:  "sekret",
+                Today:     "2019-09-01",
+                CourierID: 17,
+        }
+        r := httptest.NewRequest(http.MethodPost, "/couriers", nil)
+        r = r.WithContext(WithProblem(r.Context()))
+        Status(r, StatusErrorForm)
+        res := Response()
+        res.Errors(Validate(dto)...)
+        w := httptest.NewRecorder()
+        WriteJSON(w, r, res)
+
+        assert.Equal(t, StatusErrorForm, w.Code)
+        assert.Equal(t, ContentTypeProblem, w.Header().Get("Content-Type"))
+        var p struct {
+                Status int    `json:"status"`
+                Errors []Meta `json:"errors"`
+        }
+        require.NoError(t, json.Unmarshal(w.Body.Bytes(), &p))
+        assert.Equal(t, StatusErrorForm, p.Status)
+        assert.Equal(t, []Meta{{
+                Code:    "email",
+                Type:    "string",
+                Message: "Invalid Type nanang.jobs@gmail for input email",
+        }}, p.Errors)
+}
+
+func TestProblemExtensions(t *testing.T) {
+        r := httptest.NewRequest(http.MethodGet, "/", nil)
+        p := NewProblem(r, StatusTooManyRequests, "")
+        p.Type = "https://example.com/probs/rate-limit"
+        p.Extensions = map[string]interface{}{"retry_after": 30, "status": "ignored"}
+        b, err := json.Marshal(p)
+        require.NoError(t, err)
+        assert.JSONEq(t, `{
+                "type": "https://example.com/probs/rate-limit",
+                "title": "Too many requests, please try again later",
+                "status": 429,
+                "instance": "/",
+                "retry_after": 30
+        }`, string(b))
+}
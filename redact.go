@@ -0,0 +1,324 @@
+/*  redact.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 21, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 21/10/26 16:05
+ */
+
+package suki
+
+import (
+        "encoding"
+        "encoding/json"
+        "reflect"
+        "strings"
+        "sync"
+
+        "go.uber.org/zap"
+        "go.uber.org/zap/zapcore"
+)
+
+// Redacted replaces the value of a redacted field.
+const Redacted = "[REDACTED]"
+
+// Detector reports whether a value is sensitive whatever its key.
+type Detector func(s string) bool
+
+// Redaction selects the fields to redact, by a key containing one of the
+// Keys, in lower case, or by a string value caught by a Detector.
+// A struct field tagged `log:"redact"` is redacted in reflected values.
+type Redaction struct {
+        Keys      []string
+        Detectors []Detector
+}
+
+// DefaultRedaction is used until SetRedaction or Configure replaces it.
+var DefaultRedaction = Redaction{
+        Keys:      []string{"password", "passwd", "token", "authorization", "secret", "api_key", "apikey"},
+        Detectors: []Detector{DetectJWT, DetectCardNumber},
+}
+
+var (
+        redactionMu sync.RWMutex
+        redaction   = DefaultRedaction
+)
+
+// SetRedaction replaces the redaction of the suki cores.
+func SetRedaction(r Redaction) {
+        keys := make([]string, 0, len(r.Keys))
+        for _, k := range r.Keys {
+                keys = append(keys, strings.ToLower(k))
+        }
+        r.Keys = keys
+        redactionMu.Lock()
+        defer redactionMu.Unlock()
+        redaction = r
+}
+
+func currentRedaction() Redaction {
+        redactionMu.RLock()
+        defer redactionMu.RUnlock()
+        return redaction
+}
+
+// RedactKey reports whether the values of the key are redacted.
+func RedactKey(key string) bool {
+        return currentRedaction().Key(key)
+}
+
+// RedactValue reports whether the value is redacted whatever its key.
+func RedactValue(s string) bool {
+        return currentRedaction().Value(s)
+}
+
+func (r Redaction) Key(key string) bool {
+        if key == "" {
+                return false
+        }
+        key = strings.ToLower(key)
+        for _, k := range r.Keys {
+                if strings.Contains(key, k) {
+                        return true
+                }
+        }
+        return false
+}
+
+func (r Redaction) Value(s string) bool {
+        for _, detect := range r.Detectors {
+                if detect(s) {
+                        return true
+                }
+        }
+        return false
+}
+
+// DetectJWT catches the compact form of a JSON web token,
+// three base64url segments with a JSON header.
+func DetectJWT(s string) bool {
+        if len(s) < 20 || !strings.HasPrefix(s, "eyJ") {
+                return false
+        }
+        parts := strings.Split(s, ".")
+        if len(parts) != 3 || parts[1] == "" {
+                return false
+        }
+        for _, part := range parts {
+                for _, c := range part {
+                        if (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && (c < '0' || c > '9') && c != '-' && c != '_' {
+                                return false
+                        }
+                }
+        }
+        return true
+}
+
+// DetectCardNumber catches the 13 to 19 digits of a payment card, spaces
+// and dashes aside, that pass the Luhn check.
+func DetectCardNumber(s string) bool {
+        if len(s) < 13 || len(s) > 23 {
+                return false
+        }
+        digits := make([]int, 0, 19)
+        for _, c := range s {
+                switch {
+                case c >= '0' && c <= '9':
+                        digits = append(digits, int(c-'0'))
+                case c == ' ' || c == '-':
+                default:
+                        return false
+                }
+        }
+        if len(digits) < 13 || len(digits) > 19 {
+                return false
+        }
+        sum := 0
+        for i := range digits {
+                d := digits[len(digits)-1-i]
+                if i%2 == 1 {
+                        if d *= 2; d > 9 {
+                                d -= 9
+                        }
+                }
+                sum += d
+        }
+        return sum%10 == 0
+}
+
+// RedactCore redacts the fields written to core, r is nil to follow
+// SetRedaction. The core should be a leaf core, wrap each core of a Tee.
+func RedactCore(core Core, r *Redaction) Core {
+        return &redactCore{Core: core, redaction: r}
+}
+
+type redactCore struct {
+        zapcore.Core
+        redaction *Redaction
+}
+
+func (c *redactCore) With(fields []ZapField) Core {
+        return &redactCore{Core: c.Core.With(c.redact(fields)), redaction: c.redaction}
+}
+
+func (c *redactCore) Check(e zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+        if c.Enabled(e.Level) {
+                return ce.AddCore(e, c)
+        }
+        return ce
+}
+
+func (c *redactCore) Write(e zapcore.Entry, fields []ZapField) error {
+        return c.Core.Write(e, c.redact(fields))
+}
+
+func (c *redactCore) redact(fields []ZapField) []ZapField {
+        r := c.redaction
+        if r == nil {
+                current := currentRedaction()
+                r = &current
+        }
+        var out []ZapField
+        for i, f := range fields {
+                redacted, ok := r.field(f)
+                if !ok {
+                        if out != nil {
+                                out = append(out, f)
+                        }
+                        continue
+                }
+                // copy on the first change, the fields belong to the caller
+                if out == nil {
+                        out = make([]ZapField, i, len(fields))
+                        copy(out, fields[:i])
+                }
+                out = append(out, redacted)
+        }
+        if out == nil {
+                return fields
+        }
+        return out
+}
+
+// field returns the redacted field of f, ok is false when f is kept.
+func (r *Redaction) field(f ZapField) (ZapField, bool) {
+        if r.Key(f.Key) {
+                return zap.String(f.Key, Redacted), true
+        }
+        switch f.Type {
+        case zapcore.StringType:
+                if r.Value(f.String) {
+                        return zap.String(f.Key, Redacted), true
+                }
+        case zapcore.ReflectType:
+                if v, ok := r.value(reflect.ValueOf(f.Interface), 0); ok {
+                        return zap.Reflect(f.Key, v), true
+                }
+        }
+        return f, false
+}
+
+var (
+        jsonMarshaler = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+        textMarshaler = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// value returns a copy of v with its sensitive parts redacted, a changed
+// struct becomes a map of its JSON names. ok is false when v is kept.
+func (r *Redaction) value(v reflect.Value, depth int) (interface{}, bool) {
+        if !v.IsValid() || depth > 8 {
+                return nil, false
+        }
+        if v.Type().Implements(jsonMarshaler) || v.Type().Implements(textMarshaler) {
+                return nil, false
+        }
+        switch v.Kind() {
+        case reflect.String:
+                if r.Value(v.String()) {
+                        return Redacted, true
+                }
+        case reflect.Ptr, reflect.Interface:
+                if !v.IsNil() {
+                        return r.value(v.Elem(), depth+1)
+                }
+        case reflect.Slice, reflect.Array:
+                if v.Type().Elem().Kind() == reflect.Uint8 {
+                        return nil, false
+                }
+                var out []interface{}
+                for i := 0; i < v.Len(); i++ {
+                        if redacted, ok := r.value(v.Index(i), depth+1); ok {
+                                if out == nil {
+                                        out = make([]interface{}, v.Len())
+                                        for j := 0; j < i; j++ {
+                                                out[j] = v.Index(j).Interface()
+                                        }
+                                }
+                                out[i] = redacted
+                        } else if out != nil {
+                                out[i] = v.Index(i).Interface()
+                        }
+                }
+                if out != nil {
+                        return out, true
+                }
+        case reflect.Map:
+                if v.Type().Key().Kind() != reflect.String {
+                        return nil, false
+                }
+                changed := false
+                out := make(map[string]interface{}, v.Len())
+                iter := v.MapRange()
+                for iter.Next() {
+                        key := iter.Key().String()
+                        if r.Key(key) {
+                                out[key], changed = Redacted, true
+                        } else if redacted, ok := r.value(iter.Value(), depth+1); ok {
+                                out[key], changed = redacted, true
+                        } else {
+                                out[key] = iter.Value().Interface()
+                        }
+                }
+                if changed {
+                        return out, true
+                }
+        case reflect.Struct:
+                changed := false
+                out := make(map[string]interface{})
+                t := v.Type()
+                for i := 0; i < t.NumField(); i++ {
+                        sf := t.Field(i)
+                        if sf.PkgPath != "" {
+                                continue
+                        }
+                        name, omitempty := sf.Name, false
+                        if tag, ok := sf.Tag.Lookup("json"); ok {
+                                if tag == "-" {
+                                        continue
+                                }
+                                opts := strings.Split(tag, ",")
+                                if opts[0] != "" {
+                                        name = opts[0]
+                                }
+                                for _, opt := range opts[1:] {
+                                        omitempty = omitempty || opt == "omitempty"
+                                }
+                        }
+                        fv := v.Field(i)
+                        if omitempty && fv.IsZero() {
+                                continue
+                        }
+                        if sf.Tag.Get("log") == "redact" || r.Key(name) {
+                                out[name], changed = Redacted, true
+                        } else if redacted, ok := r.value(fv, depth+1); ok {
+                                out[name], changed = redacted, true
+                        } else {
+                                out[name] = fv.Interface()
+                        }
+                }
+                if changed {
+                        return out, true
+                }
+        }
+        return nil, false
+}
@@ -0,0 +1,20 @@
+//go:build !windows
+// +build !windows
+
+/*  reexec_unix.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 19, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 19/10/26 09:40
+ */
+
+package suki
+
+import (
+        "os"
+        "syscall"
+)
+
+// reexecSignals trigger a graceful re-exec of the http command.
+var reexecSignals = []os.Signal{syscall.SIGUSR2}
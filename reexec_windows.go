@@ -0,0 +1,17 @@
+//go:build windows
+// +build windows
+
+/*  reexec_windows.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 19, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 19/10/26 09:40
+ */
+
+package suki
+
+import "os"
+
+// reexecSignals is empty, re-exec is not supported on windows.
+var reexecSignals []os.Signal
@@ -0,0 +1,224 @@
+/*  render.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 19, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 19/10/26 15:30
+ */
+
+package suki
+
+import (
+        "bytes"
+        "encoding/csv"
+        "encoding/json"
+        "encoding/xml"
+        "io"
+        "mime"
+        "net/http"
+        "sort"
+        "strconv"
+        "strings"
+        "sync"
+
+        "github.com/ugorji/go/codec"
+)
+
+// Encoder writes a value in the media type of ContentType.
+type Encoder interface {
+        ContentType() string
+        Encode(w io.Writer, v interface{}) error
+}
+
+type encoderFunc struct {
+        contentType string
+        encode      func(w io.Writer, v interface{}) error
+}
+
+func (e encoderFunc) ContentType() string {
+        return e.contentType
+}
+
+func (e encoderFunc) Encode(w io.Writer, v interface{}) error {
+        return e.encode(w, v)
+}
+
+// NewEncoder creates an Encoder from an encode function.
+func NewEncoder(contentType string, encode func(w io.Writer, v interface{}) error) Encoder {
+        return encoderFunc{contentType: contentType, encode: encode}
+}
+
+type registeredEncoder struct {
+        format     string
+        mediaTypes []string
+        encoder    Encoder
+}
+
+var (
+        encodersMu sync.RWMutex
+        encoders   []registeredEncoder // the first one is the default
+)
+
+func init() {
+        RegisterEncoder("json", NewEncoder("application/json; charset=utf-8", func(w io.Writer, v interface{}) error {
+                enc := json.NewEncoder(w)
+                enc.SetEscapeHTML(true)
+                return enc.Encode(v)
+        }))
+        RegisterEncoder("xml", NewEncoder("application/xml; charset=utf-8", func(w io.Writer, v interface{}) error {
+                if _, err := io.WriteString(w, xml.Header); err != nil {
+                        return err
+                }
+                return xml.NewEncoder(w).Encode(v)
+        }), "application/xml", "text/xml")
+        RegisterEncoder("msgpack", NewEncoder("application/msgpack", func(w io.Writer, v interface{}) error {
+                return codec.NewEncoder(w, &codec.MsgpackHandle{WriteExt: true}).Encode(v)
+        }), "application/msgpack", "application/x-msgpack")
+        RegisterEncoder("csv", NewEncoder("text/csv; charset=utf-8", func(w io.Writer, v interface{}) error {
+                if res, ok := v.(*response); ok {
+                        v = res.Data
+                }
+                rows, err := MarshalCSV(v)
+                if err != nil {
+                        return err
+                }
+                return csv.NewWriter(w).WriteAll(rows)
+        }))
+}
+
+// RegisterEncoder registers the encoder under the format name used by the
+// ?format= parameter and the media types matched against the Accept header,
+// by default the media type of its ContentType. Registering a format again
+// replaces its encoder.
+func RegisterEncoder(format string, enc Encoder, mediaTypes ...string) {
+        if len(mediaTypes) == 0 {
+                mt, _, _ := mime.ParseMediaType(enc.ContentType())
+                mediaTypes = []string{mt}
+        }
+        encodersMu.Lock()
+        defer encodersMu.Unlock()
+        for i, e := range encoders {
+                if e.format == format {
+                        encoders[i] = registeredEncoder{format, mediaTypes, enc}
+                        return
+                }
+        }
+        encoders = append(encoders, registeredEncoder{format, mediaTypes, enc})
+}
+
+type acceptRange struct {
+        mediaType string
+        q         float64
+}
+
+// parseAccept returns the media ranges of the Accept header ordered by
+// quality, the q=0 ranges last.
+func parseAccept(header string) []acceptRange {
+        ranges := make([]acceptRange, 0)
+        for _, part := range strings.Split(header, ",") {
+                mt, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+                if err != nil {
+                        continue
+                }
+                q := 1.0
+                if v, ok := params["q"]; ok {
+                        if q, err = strconv.ParseFloat(v, 64); err != nil {
+                                continue
+                        }
+                }
+                if q >= 0 {
+                        ranges = append(ranges, acceptRange{mediaType: mt, q: q})
+                }
+        }
+        sort.SliceStable(ranges, func(i, j int) bool {
+                return ranges[i].q > ranges[j].q
+        })
+        return ranges
+}
+
+// specificity ranks "*/*" below "type/*" below a full media type.
+func specificity(pattern string) int {
+        switch {
+        case pattern == "*/*":
+                return 0
+        case strings.HasSuffix(pattern, "/*"):
+                return 1
+        }
+        return 2
+}
+
+// refused reports whether a q=0 range more specific than pattern matches
+// the media type, e.g. "application/xml;q=0" refuses XML to "*/*".
+func refused(ranges []acceptRange, pattern, mediaType string) bool {
+        for _, ar := range ranges {
+                if ar.q == 0 && specificity(ar.mediaType) > specificity(pattern) &&
+                        matchMediaType(ar.mediaType, mediaType) {
+                        return true
+                }
+        }
+        return false
+}
+
+func matchMediaType(pattern, mediaType string) bool {
+        if pattern == "*/*" || pattern == mediaType {
+                return true
+        }
+        return strings.HasSuffix(pattern, "/*") &&
+                strings.HasPrefix(mediaType, strings.TrimSuffix(pattern, "*"))
+}
+
+// Negotiate returns the encoder selected by the ?format= parameter or
+// else by the Accept header of the request.
+func Negotiate(r *http.Request) (Encoder, bool) {
+        encodersMu.RLock()
+        defer encodersMu.RUnlock()
+        if format := r.URL.Query().Get("format"); format != "" {
+                for _, e := range encoders {
+                        if e.format == format {
+                                return e.encoder, true
+                        }
+                }
+                return nil, false
+        }
+        accept := r.Header.Get("Accept")
+        if accept == "" && len(encoders) > 0 {
+                return encoders[0].encoder, true
+        }
+        ranges := parseAccept(accept)
+        for _, ar := range ranges {
+                if ar.q == 0 {
+                        break
+                }
+                for _, e := range encoders {
+                        for _, mt := range e.mediaTypes {
+                                if matchMediaType(ar.mediaType, mt) && !refused(ranges, ar.mediaType, mt) {
+                                        return e.encoder, true
+                                }
+                        }
+                }
+        }
+        return nil, false
+}
+
+// Render writes v with the encoder negotiated for the request and the
+// status set by Status, or a 406 envelope when no encoder matches.
+func Render(w http.ResponseWriter, r *http.Request, v interface{}) {
+        w.Header().Add("Vary", "Accept")
+        enc, ok := Negotiate(r)
+        if !ok {
+                WriteError(w, r, ErrNotAcceptable)
+                return
+        }
+        buf := &bytes.Buffer{}
+        if err := enc.Encode(buf, v); err != nil {
+                http.Error(w, err.Error(), http.StatusInternalServerError)
+                return
+        }
+        w.Header().Set("Content-Type", enc.ContentType())
+        if status, ok := ResponseStatus(w, r); ok {
+                w.WriteHeader(status)
+        }
+        if _, err := w.Write(buf.Bytes()); err != nil {
+                http.Error(w, err.Error(), http.StatusInternalServerError)
+        }
+}
@@ -0,0 +1,153 @@
+/*  render_test.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 19, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 19/10/26 16:10
+ */
+
+package suki
+
+import (
+        "fmt"
+        "io"
+        "net/http"
+        "net/http/httptest"
+        "testing"
+
+        "github.com/stretchr/testify/assert"
+        "github.com/stretchr/testify/require"
+        "github.com/ugorji/go/codec"
+)
+
+type fleet struct {
+        Number   string  `json:"number"`
+        Area     string  `json:"area"`
+        Distance float64 `json:"distance" csv:"Jarak Warehouse"`
+        secret   string
+}
+
+func renderResponse() *response {
+        res := Response()
+        res.Body([]fleet{
+                {Number: "SO45678", Area: "Jakarta Selatan", Distance: 45},
+                {Number: "SO45645", Area: "Jakarta Selatan", Distance: 43.5},
+        })
+        res.Page(Pagination{Page: 1, Size: 20, Total: 2})
+        return res
+}
+
+func TestRender(t *testing.T) {
+        tests := []struct {
+                name        string
+                target      string
+                accept      string
+                contentType string
+                body        string
+        }{
+                {
+                        name:        "Default JSON",
+                        target:      "/",
+                        contentType: "application/json; charset=utf-8",
+                        body:        `{"meta":{},"data":[{"number":"SO45678","area":"Jakarta Selatan","distance":45},{"number":"SO45645","area":"Jakarta Selatan","distance":43.5}],"pagination":{"page":1,"size":20,"total":2}}` + "\n",
+                },
+                {
+                        name:        "Accept XML with quality",
+                        target:      "/",
+                        accept:      "application/json;q=0.5, text/xml",
+                        contentType: "application/xml; charset=utf-8",
+                        body: `<?xml version="1.0" encoding="UTF-8"?>` + "\n" +
+                                `<response><data><Number>SO45678</Number><Area>Jakarta Selatan</Area><Distance>45</Distance></data>` +
+                                `<data><Number>SO45645</Number><Area>Jakarta Selatan</Area><Distance>43.5</Distance></data>` +
+                                `<pagination><page>1</page><size>20</size><total>2</total></pagination></response>`,
+                },
+                {
+                        name:        "Format CSV",
+                        target:      "/?format=csv",
+                        accept:      "application/json",
+                        contentType: "text/csv; charset=utf-8",
+                        body:        "number,area,Jarak Warehouse\nSO45678,Jakarta Selatan,45\nSO45645,Jakarta Selatan,43.5\n",
+                },
+        }
+        for _, tt := range tests {
+                t.Run(tt.name, func(t *testing.T) {
+                        r := httptest.NewRequest(http.MethodGet, tt.target, nil)
+                        r.Header.Set("Accept", tt.accept)
+                        w := httptest.NewRecorder()
+                        Render(w, r, renderResponse())
+
+                        assert.Equal(t, StatusSuccess, w.Code)
+                        assert.Equal(t, tt.contentType, w.Header().Get("Content-Type"))
+                        assert.Equal(t, tt.body, w.Body.String())
+                })
+        }
+}
+
+func TestRenderMsgpack(t *testing.T) {
+        r := httptest.NewRequest(http.MethodGet, "/", nil)
+        r.Header.Set("Accept", "application/x-msgpack")
+        Status(r, StatusCreated)
+        w := httptest.NewRecorder()
+        Render(w, r, renderResponse())
+
+        assert.Equal(t, StatusCreated, w.Code)
+        assert.Equal(t, "application/msgpack", w.Header().Get("Content-Type"))
+        var out map[string]interface{}
+        require.NoError(t, codec.NewDecoderBytes(w.Body.Bytes(), &codec.MsgpackHandle{}).Decode(&out))
+        assert.Len(t, out["data"], 2)
+}
+
+func TestRenderNotAcceptable(t *testing.T) {
+        r := httptest.NewRequest(http.MethodGet, "/", nil)
+        r.Header.Set("Accept", "image/png")
+        w := httptest.NewRecorder()
+        Render(w, r, renderResponse())
+
+        assert.Equal(t, StatusNotAcceptable, w.Code)
+        assert.Contains(t, w.Body.String(), `"code":"STATUS_NOT_ACCEPTABLE"`)
+
+        r = httptest.NewRequest(http.MethodGet, "/?format=yaml", nil)
+        w = httptest.NewRecorder()
+        Render(w, r, renderResponse())
+        assert.Equal(t, StatusNotAcceptable, w.Code)
+}
+
+func TestNegotiateRefused(t *testing.T) {
+        tests := []struct {
+                accept      string
+                contentType string
+        }{
+                {accept: "*/*, application/xml;q=0", contentType: "application/json; charset=utf-8"},
+                {accept: "*/*, application/json;q=0", contentType: "application/xml; charset=utf-8"},
+                {accept: "application/*, application/json;q=0", contentType: "application/xml; charset=utf-8"},
+                {accept: "text/*, */*;q=0.5, text/xml;q=0", contentType: "text/csv; charset=utf-8"},
+        }
+        for _, tt := range tests {
+                r := httptest.NewRequest(http.MethodGet, "/", nil)
+                r.Header.Set("Accept", tt.accept)
+                w := httptest.NewRecorder()
+                Render(w, r, renderResponse())
+                assert.Equal(t, StatusSuccess, w.Code, tt.accept)
+                assert.Equal(t, tt.contentType, w.Header().Get("Content-Type"), tt.accept)
+        }
+
+        r := httptest.NewRequest(http.MethodGet, "/", nil)
+        r.Header.Set("Accept", "application/json;q=0")
+        w := httptest.NewRecorder()
+        Render(w, r, renderResponse())
+        assert.Equal(t, StatusNotAcceptable, w.Code)
+}
+
+func TestRegisterEncoder(t *testing.T) {
+        RegisterEncoder("text", NewEncoder("text/plain; charset=utf-8", func(w io.Writer, v interface{}) error {
+                _, err := fmt.Fprint(w, v)
+                return err
+        }))
+        r := httptest.NewRequest(http.MethodGet, "/", nil)
+        r.Header.Set("Accept", "text/plain")
+        w := httptest.NewRecorder()
+        Render(w, r, "hello")
+
+        assert.Equal(t, "text/plain; charset=utf-8", w.Header().Get("Content-Type"))
+        assert.Equal(t, "hello", w.Body.String())
+}
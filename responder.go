@@ -0,0 +1,152 @@
+/*  responder.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 21, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 21/10/26 11:20
+ */
+
+package suki
+
+import (
+        "bufio"
+        "errors"
+        "net"
+        "net/http"
+)
+
+// Responder wraps the http.ResponseWriter of a handler, it carries the
+// status of the response until the body is written and records the status
+// and the bytes written. The handlers of a ruuto router receive one:
+//
+//	rw := suki.NewResponder(w)
+//	rw.Status(suki.StatusCreated).JSON(r, res)
+type Responder struct {
+        http.ResponseWriter
+        pending     int
+        status      int
+        wroteHeader bool
+        written     int64
+}
+
+// NewResponder returns w when it is already a Responder, else wraps it.
+func NewResponder(w http.ResponseWriter) *Responder {
+        if rw, ok := w.(*Responder); ok {
+                return rw
+        }
+        return &Responder{ResponseWriter: w}
+}
+
+// Status sets the status written with the body of the response.
+func (rw *Responder) Status(status int) *Responder {
+        rw.pending = status
+        return rw
+}
+
+// StatusCode returns the status written, or the one set by Status
+// while the header is not written yet.
+func (rw *Responder) StatusCode() int {
+        if rw.wroteHeader {
+                return rw.status
+        }
+        if rw.pending != 0 {
+                return rw.pending
+        }
+        return http.StatusOK
+}
+
+// BytesWritten returns the size of the body written so far.
+func (rw *Responder) BytesWritten() int64 {
+        return rw.written
+}
+
+// Written reports whether the header of the response is written.
+func (rw *Responder) Written() bool {
+        return rw.wroteHeader
+}
+
+func (rw *Responder) WriteHeader(status int) {
+        if rw.wroteHeader {
+                return
+        }
+        rw.wroteHeader = true
+        rw.status = status
+        rw.ResponseWriter.WriteHeader(status)
+}
+
+func (rw *Responder) Write(p []byte) (int, error) {
+        if !rw.wroteHeader {
+                rw.WriteHeader(rw.StatusCode())
+        }
+        n, err := rw.ResponseWriter.Write(p)
+        rw.written += int64(n)
+        return n, err
+}
+
+// JSON writes v as WriteJSON does.
+func (rw *Responder) JSON(r *http.Request, v interface{}) {
+        WriteJSON(rw, r, v)
+}
+
+// CSV writes the rows as WriteCSV does.
+func (rw *Responder) CSV(r *http.Request, rows [][]string, filename string) {
+        WriteCSV(rw, r, rows, filename)
+}
+
+// NoContent writes the 204 No Content status without a body.
+func (rw *Responder) NoContent() {
+        rw.WriteHeader(StatusNoContent)
+}
+
+// Redirect replies with a redirect to url, a status that is not
+// a redirection is replaced by 302 Found.
+func (rw *Responder) Redirect(r *http.Request, url string, status int) {
+        if status < http.StatusMultipleChoices || status > http.StatusPermanentRedirect {
+                status = http.StatusFound
+        }
+        http.Redirect(rw, r, url, status)
+}
+
+// Flush sends the buffered data when the wrapped writer supports it.
+func (rw *Responder) Flush() {
+        if !rw.wroteHeader {
+                rw.WriteHeader(rw.StatusCode())
+        }
+        if f, ok := rw.ResponseWriter.(http.Flusher); ok {
+                f.Flush()
+        }
+}
+
+// Hijack lets the handler take over the connection.
+func (rw *Responder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+        h, ok := rw.ResponseWriter.(http.Hijacker)
+        if !ok {
+                return nil, nil, errors.New("suki: the response writer does not support hijacking")
+        }
+        return h.Hijack()
+}
+
+// Unwrap returns the wrapped writer, as used by http.ResponseController.
+func (rw *Responder) Unwrap() http.ResponseWriter {
+        return rw.ResponseWriter
+}
+
+// ResponseStatus returns the status to write for the response, set on its
+// Responder or by Status on the request.
+func ResponseStatus(w http.ResponseWriter, r *http.Request) (int, bool) {
+        if rw, ok := w.(*Responder); ok && rw.pending != 0 {
+                return rw.pending, true
+        }
+        status, ok := r.Context().Value(CtxResponse).(int)
+        return status, ok
+}
+
+// setStatus sets the status on the Responder of the response,
+// or on the request when the writer is not one.
+func setStatus(w http.ResponseWriter, r *http.Request, status int) {
+        if rw, ok := w.(*Responder); ok {
+                rw.Status(status)
+                return
+        }
+        Status(r, status)
+}
@@ -0,0 +1,81 @@
+/*  responder_test.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 21, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 21/10/26 12:00
+ */
+
+package suki
+
+import (
+        "net/http"
+        "net/http/httptest"
+        "testing"
+
+        "github.com/stretchr/testify/assert"
+)
+
+func TestResponderJSON(t *testing.T) {
+        r := httptest.NewRequest(http.MethodPost, "/orders", nil)
+        ctx := r.Context()
+        w := httptest.NewRecorder()
+        rw := NewResponder(w)
+        assert.Same(t, rw, NewResponder(rw))
+
+        res := Response()
+        res.Success(StatusCode(StatusCreated))
+        rw.Status(StatusCreated).JSON(r, res)
+
+        assert.Equal(t, StatusCreated, w.Code)
+        assert.Equal(t, StatusCreated, rw.StatusCode())
+        assert.Equal(t, int64(w.Body.Len()), rw.BytesWritten())
+        assert.True(t, rw.Written())
+        assert.Equal(t, ctx, r.Context(), "the request is not replaced")
+}
+
+func TestResponderError(t *testing.T) {
+        r := httptest.NewRequest(http.MethodGet, "/orders/1", nil)
+        w := httptest.NewRecorder()
+        rw := NewResponder(w).Status(StatusSuccess)
+        rw.JSON(r, ErrNotFound)
+
+        assert.Equal(t, StatusNotFound, w.Code)
+        assert.Equal(t, StatusNotFound, rw.StatusCode())
+        assert.Contains(t, w.Body.String(), `"error_type":"NOT_FOUND"`)
+}
+
+func TestResponderStatusShim(t *testing.T) {
+        r := httptest.NewRequest(http.MethodGet, "/fleets", nil)
+        Status(r, StatusAccepted)
+        w := httptest.NewRecorder()
+        rw := NewResponder(w)
+        rw.CSV(r, [][]string{{"a", "b"}}, "fleets")
+
+        assert.Equal(t, StatusAccepted, w.Code)
+        assert.Equal(t, StatusAccepted, rw.StatusCode())
+        assert.Equal(t, "a,b\n", w.Body.String())
+}
+
+func TestResponderNoContentRedirect(t *testing.T) {
+        w := httptest.NewRecorder()
+        rw := NewResponder(w)
+        rw.NoContent()
+        assert.Equal(t, StatusNoContent, w.Code)
+        assert.Zero(t, rw.BytesWritten())
+
+        r := httptest.NewRequest(http.MethodGet, "/old", nil)
+        w = httptest.NewRecorder()
+        rw = NewResponder(w)
+        rw.Redirect(r, "/new", StatusSuccess)
+        assert.Equal(t, http.StatusFound, w.Code)
+        assert.Equal(t, "/new", w.Header().Get("Location"))
+
+        w = httptest.NewRecorder()
+        rw = NewResponder(w)
+        rw.Flush()
+        assert.True(t, w.Flushed)
+        assert.Equal(t, w, rw.Unwrap())
+        _, _, err := rw.Hijack()
+        assert.Error(t, err)
+}
@@ -10,7 +10,10 @@ package suki
 
 import (
         "context"
+        "encoding/xml"
         "net/http"
+        "reflect"
+        "sort"
 )
 
 type ctxKeyResponse struct {
@@ -24,15 +27,17 @@ func (r *ctxKeyResponse) String() string {
 var CtxResponse = ctxKeyResponse{Name: "context response"}
 
 type Pagination struct {
-        Page  int `json:"page"`
-        Size  int `json:"size"`
-        Total int `json:"total"`
+        Page       int    `json:"page" xml:"page"`
+        Size       int    `json:"size" xml:"size"`
+        Total      int    `json:"total" xml:"total"`
+        TotalPages int    `json:"total_pages,omitempty" xml:"total_pages,omitempty"`
+        Links      *Links `json:"links,omitempty" xml:"links,omitempty"`
 }
 
 type Meta struct {
-        Code    string `json:"code,omitempty"`
-        Type    string `json:"error_type,omitempty"`
-        Message string `json:"error_message,omitempty"`
+        Code    string `json:"code,omitempty" xml:"code,omitempty"`
+        Type    string `json:"error_type,omitempty" xml:"error_type,omitempty"`
+        Message string `json:"error_message,omitempty" xml:"error_message,omitempty"`
 }
 
 type response struct {
@@ -66,6 +71,59 @@ func (r *response) Page(p Pagination) {
         r.Pagination = p
 }
 
+// MarshalXML writes the envelope as a <response> element
+// with the parts that are not empty.
+func (r *response) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+        start = xml.StartElement{Name: xml.Name{Local: "response"}}
+        if err := e.EncodeToken(start); err != nil {
+                return err
+        }
+        parts := []struct {
+                name  string
+                value interface{}
+        }{{"meta", r.Meta}, {"data", r.Data}, {"pagination", r.Pagination}}
+        for _, part := range parts {
+                v := reflect.ValueOf(part.value)
+                if !v.IsValid() || (v.Kind() == reflect.Map && v.Len() == 0) {
+                        continue
+                }
+                if err := e.EncodeElement(xmlValue(part.value), xml.StartElement{Name: xml.Name{Local: part.name}}); err != nil {
+                        return err
+                }
+        }
+        return e.EncodeToken(start.End())
+}
+
+// xmlMap writes a map as child elements ordered by key.
+type xmlMap map[string]interface{}
+
+func (m xmlMap) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+        if err := e.EncodeToken(start); err != nil {
+                return err
+        }
+        keys := make([]string, 0, len(m))
+        for k := range m {
+                keys = append(keys, k)
+        }
+        sort.Strings(keys)
+        for _, k := range keys {
+                if err := e.EncodeElement(xmlValue(m[k]), xml.StartElement{Name: xml.Name{Local: k}}); err != nil {
+                        return err
+                }
+        }
+        return e.EncodeToken(start.End())
+}
+
+func xmlValue(v interface{}) interface{} {
+        if m, ok := v.(map[string]interface{}); ok {
+                return xmlMap(m)
+        }
+        return v
+}
+
+// Status sets the status of the response on the request context, it is
+// kept for compatibility, a Responder carries the status without replacing
+// the request, see Responder.Status.
 func Status(r *http.Request, status int) {
         *r = *r.WithContext(context.WithValue(r.Context(), CtxResponse, status))
 }
@@ -7,6 +7,7 @@ import (
         "net/http"
 
         "github.com/go-chi/chi"
+        "gitlab.com/suryakencana007/suki"
 )
 
 // ChiRouter is an adapter for chi router that implements the Router interface
@@ -121,6 +122,19 @@ func (r *ChiRouter) RoutesCount() int {
         return r.routesCount(r.mux)
 }
 
+var _ suki.RouteLister = (*ChiRouter)(nil)
+
+// Routes returns the method and pattern of every registered route,
+// listed by the admin server through suki.RouteLister.
+func (r *ChiRouter) Routes() []suki.Route {
+        routes := make([]suki.Route, 0)
+        _ = chi.Walk(r.mux, func(method string, route string, handler http.Handler, middlewares ...func(http.Handler) http.Handler) error {
+                routes = append(routes, suki.Route{Method: method, Pattern: route})
+                return nil
+        })
+        return routes
+}
+
 func (r *ChiRouter) routesCount(routes chi.Routes) int {
         count := len(routes.Routes())
         for _, route := range routes.Routes() {
@@ -131,8 +145,16 @@ func (r *ChiRouter) routesCount(routes chi.Routes) int {
         return count
 }
 
+// with wraps the route handlers with the middlewares, the handler
+// receives the response writer as a *suki.Responder.
 func (r *ChiRouter) with(handlers ...Constructor) chi.Router {
-        return r.mux.With(r.wrapConstructor(handlers)...)
+        return r.mux.With(append(r.wrapConstructor(handlers), responder)...)
+}
+
+func responder(next http.Handler) http.Handler {
+        return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+                next.ServeHTTP(suki.NewResponder(w), req)
+        })
 }
 
 func (r *ChiRouter) wrapConstructor(handlers []Constructor) []func(http.Handler) http.Handler {
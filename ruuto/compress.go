@@ -0,0 +1,214 @@
+/*  compress.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 20, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 20/10/26 19:10
+ */
+
+package ruuto
+
+import (
+        "bytes"
+        "compress/flate"
+        "compress/gzip"
+        "io"
+        "mime"
+        "net/http"
+        "strconv"
+        "strings"
+
+        "github.com/felixge/httpsnoop"
+)
+
+// DefaultCompressTypes are the content types compressed when
+// CompressOptions.ContentTypes is empty, "text/*" matches any text type.
+var DefaultCompressTypes = []string{
+        "text/*",
+        "application/json",
+        "application/problem+json",
+        "application/vnd.api+json",
+        "application/x-ndjson",
+        "application/xml",
+        "application/javascript",
+        "image/svg+xml",
+}
+
+type CompressOptions struct {
+        Level        int      // compression level, flate.DefaultCompression by default
+        MinSize      int      // smaller bodies are sent as they are, 1024 bytes by default
+        ContentTypes []string // content types to compress, DefaultCompressTypes by default
+}
+
+// Compress gzip or deflate compresses the responses by the Accept-Encoding
+// of the request. The response writer keeps the interfaces of the one it
+// wraps, a Flush sends what is compressed so far.
+func Compress(opts CompressOptions) func(next http.Handler) http.Handler {
+        if opts.Level == 0 {
+                opts.Level = flate.DefaultCompression
+        }
+        if opts.MinSize <= 0 {
+                opts.MinSize = 1024
+        }
+        if len(opts.ContentTypes) == 0 {
+                opts.ContentTypes = DefaultCompressTypes
+        }
+        return func(next http.Handler) http.Handler {
+                return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+                        w.Header().Add("Vary", "Accept-Encoding")
+                        encoding := acceptEncoding(r.Header.Get("Accept-Encoding"))
+                        if encoding == "" || r.Method == http.MethodHead {
+                                next.ServeHTTP(w, r)
+                                return
+                        }
+                        cw := &compressWriter{w: w, opts: opts, encoding: encoding, status: http.StatusOK}
+                        defer cw.Close()
+                        next.ServeHTTP(httpsnoop.Wrap(w, httpsnoop.Hooks{
+                                WriteHeader: func(httpsnoop.WriteHeaderFunc) httpsnoop.WriteHeaderFunc {
+                                        return cw.WriteHeader
+                                },
+                                Write: func(httpsnoop.WriteFunc) httpsnoop.WriteFunc {
+                                        return cw.Write
+                                },
+                                Flush: func(httpsnoop.FlushFunc) httpsnoop.FlushFunc {
+                                        return cw.Flush
+                                },
+                                ReadFrom: func(httpsnoop.ReadFromFunc) httpsnoop.ReadFromFunc {
+                                        return func(src io.Reader) (int64, error) {
+                                                return io.Copy(struct{ io.Writer }{cw}, src)
+                                        }
+                                },
+                        }), r)
+                })
+        }
+}
+
+// acceptEncoding returns the encoding to use, gzip before deflate.
+func acceptEncoding(header string) string {
+        accepted := make(map[string]bool)
+        for _, part := range strings.Split(header, ",") {
+                name, q := part, 1.0
+                if idx := strings.Index(part, ";"); idx != -1 {
+                        name = part[:idx]
+                        if v := strings.TrimSpace(part[idx+1:]); strings.HasPrefix(v, "q=") {
+                                q, _ = strconv.ParseFloat(v[2:], 64)
+                        }
+                }
+                accepted[strings.ToLower(strings.TrimSpace(name))] = q > 0
+        }
+        for _, encoding := range []string{"gzip", "deflate"} {
+                if ok, set := accepted[encoding]; ok || (!set && accepted["*"]) {
+                        return encoding
+                }
+        }
+        return ""
+}
+
+// compressWriter holds the status and the first bytes of the body until
+// it knows whether the response is worth compressing.
+type compressWriter struct {
+        w        http.ResponseWriter
+        opts     CompressOptions
+        encoding string
+        status   int
+        buf      bytes.Buffer
+        decided  bool
+        enc      io.WriteCloser
+}
+
+func (c *compressWriter) WriteHeader(status int) {
+        if !c.decided {
+                c.status = status
+        }
+}
+
+func (c *compressWriter) Write(p []byte) (int, error) {
+        if c.decided {
+                if c.enc != nil {
+                        return c.enc.Write(p)
+                }
+                return c.w.Write(p)
+        }
+        c.buf.Write(p)
+        if c.buf.Len() >= c.opts.MinSize {
+                if err := c.decide(true); err != nil {
+                        return 0, err
+                }
+        }
+        return len(p), nil
+}
+
+func (c *compressWriter) Flush() {
+        if !c.decided {
+                // a streamed response is compressed whatever its size so far
+                if err := c.decide(true); err != nil {
+                        return
+                }
+        }
+        if f, ok := c.enc.(interface{ Flush() error }); ok {
+                _ = f.Flush()
+        }
+        if f, ok := c.w.(http.Flusher); ok {
+                f.Flush()
+        }
+}
+
+// Close sends a response smaller than MinSize as it is
+// and ends the compressed stream.
+func (c *compressWriter) Close() {
+        if !c.decided {
+                _ = c.decide(false)
+        }
+        if c.enc != nil {
+                _ = c.enc.Close()
+        }
+}
+
+func (c *compressWriter) decide(large bool) error {
+        c.decided = true
+        h := c.w.Header()
+        if h.Get("Content-Type") == "" && c.buf.Len() > 0 {
+                h.Set("Content-Type", http.DetectContentType(c.buf.Bytes()))
+        }
+        if large && c.compressible(h) {
+                h.Del("Content-Length")
+                h.Set("Content-Encoding", c.encoding)
+                // the compressed body is another representation of the resource
+                if etag := h.Get("ETag"); etag != "" && !strings.HasPrefix(etag, "W/") {
+                        h.Set("ETag", "W/"+etag)
+                }
+                var err error
+                if c.encoding == "gzip" {
+                        c.enc, err = gzip.NewWriterLevel(c.w, c.opts.Level)
+                } else {
+                        c.enc, err = flate.NewWriter(c.w, c.opts.Level)
+                }
+                if err != nil {
+                        return err
+                }
+        }
+        c.w.WriteHeader(c.status)
+        if c.buf.Len() == 0 {
+                return nil
+        }
+        _, err := c.Write(c.buf.Bytes())
+        c.buf.Reset()
+        return err
+}
+
+func (c *compressWriter) compressible(h http.Header) bool {
+        if h.Get("Content-Encoding") != "" || c.status < http.StatusOK ||
+                c.status == http.StatusNoContent || c.status == http.StatusNotModified {
+                return false
+        }
+        mediaType, _, err := mime.ParseMediaType(h.Get("Content-Type"))
+        if err != nil {
+                return false
+        }
+        for _, t := range c.opts.ContentTypes {
+                if t == mediaType || (strings.HasSuffix(t, "/*") && strings.HasPrefix(mediaType, t[:len(t)-1])) {
+                        return true
+                }
+        }
+        return false
+}
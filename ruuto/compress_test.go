@@ -0,0 +1,140 @@
+/*  compress_test.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 22, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 22/10/26 10:20
+ */
+
+package ruuto
+
+import (
+        "compress/flate"
+        "compress/gzip"
+        "io"
+        "io/ioutil"
+        "net/http"
+        "net/http/httptest"
+        "strings"
+        "testing"
+
+        "github.com/stretchr/testify/assert"
+        "github.com/stretchr/testify/require"
+)
+
+var courierJSON = `{"couriers":[` + strings.Repeat(`{"name":"Budi","zone":"Jakarta Selatan"},`, 40) + `{}]}`
+
+func courierHandler(contentType, body string) http.HandlerFunc {
+        return func(w http.ResponseWriter, r *http.Request) {
+                if contentType != "" {
+                        w.Header().Set("Content-Type", contentType)
+                }
+                _, _ = io.WriteString(w, body)
+        }
+}
+
+func compressed(h http.Handler, acceptEncoding string) *httptest.ResponseRecorder {
+        r := httptest.NewRequest(http.MethodGet, "/couriers", nil)
+        if acceptEncoding != "" {
+                r.Header.Set("Accept-Encoding", acceptEncoding)
+        }
+        w := httptest.NewRecorder()
+        h.ServeHTTP(w, r)
+        return w
+}
+
+func TestCompressNegotiation(t *testing.T) {
+        h := Compress(CompressOptions{})(courierHandler("application/json", courierJSON))
+        tests := []struct {
+                name     string
+                accept   string
+                encoding string
+        }{
+                {name: "None", accept: "", encoding: ""},
+                {name: "Gzip", accept: "gzip", encoding: "gzip"},
+                {name: "GzipFirst", accept: "deflate, gzip", encoding: "gzip"},
+                {name: "Deflate", accept: "gzip;q=0, deflate", encoding: "deflate"},
+                {name: "Wildcard", accept: "*", encoding: "gzip"},
+                {name: "Identity", accept: "identity, br", encoding: ""},
+        }
+        for _, tt := range tests {
+                t.Run(tt.name, func(t *testing.T) {
+                        w := compressed(h, tt.accept)
+                        assert.Equal(t, http.StatusOK, w.Code)
+                        assert.Equal(t, tt.encoding, w.Header().Get("Content-Encoding"))
+                        assert.Equal(t, "Accept-Encoding", w.Header().Get("Vary"))
+
+                        var body io.Reader = w.Body
+                        switch tt.encoding {
+                        case "gzip":
+                                zr, err := gzip.NewReader(w.Body)
+                                require.NoError(t, err)
+                                body = zr
+                        case "deflate":
+                                body = flate.NewReader(w.Body)
+                        }
+                        raw, err := ioutil.ReadAll(body)
+                        require.NoError(t, err)
+                        assert.Equal(t, courierJSON, string(raw))
+                })
+        }
+}
+
+func TestCompressMinSize(t *testing.T) {
+        small := Compress(CompressOptions{})(courierHandler("application/json", `{"name":"Budi"}`))
+        w := compressed(small, "gzip")
+        assert.Empty(t, w.Header().Get("Content-Encoding"))
+        assert.Equal(t, `{"name":"Budi"}`, w.Body.String())
+
+        lowered := Compress(CompressOptions{MinSize: 8})(courierHandler("application/json", `{"name":"Budi"}`))
+        assert.Equal(t, "gzip", compressed(lowered, "gzip").Header().Get("Content-Encoding"))
+}
+
+func TestCompressContentTypes(t *testing.T) {
+        png := Compress(CompressOptions{})(courierHandler("image/png", courierJSON))
+        assert.Empty(t, compressed(png, "gzip").Header().Get("Content-Encoding"))
+
+        text := Compress(CompressOptions{})(courierHandler("text/csv; charset=utf-8", courierJSON))
+        assert.Equal(t, "gzip", compressed(text, "gzip").Header().Get("Content-Encoding"), "text/* matches")
+
+        detected := Compress(CompressOptions{})(courierHandler("", strings.Repeat("courier ", 200)))
+        w := compressed(detected, "gzip")
+        assert.Equal(t, "gzip", w.Header().Get("Content-Encoding"))
+        assert.Equal(t, "text/plain; charset=utf-8", w.Header().Get("Content-Type"))
+
+        only := Compress(CompressOptions{ContentTypes: []string{"application/xml"}})(courierHandler("application/json", courierJSON))
+        assert.Empty(t, compressed(only, "gzip").Header().Get("Content-Encoding"))
+}
+
+func TestCompressWithoutBody(t *testing.T) {
+        for _, status := range []int{http.StatusNoContent, http.StatusNotModified} {
+                h := Compress(CompressOptions{MinSize: 1})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+                        w.Header().Set("Content-Type", "application/json")
+                        w.WriteHeader(status)
+                }))
+                w := compressed(h, "gzip")
+                assert.Equal(t, status, w.Code)
+                assert.Empty(t, w.Header().Get("Content-Encoding"), "status %d", status)
+                assert.Zero(t, w.Body.Len(), "status %d", status)
+        }
+}
+
+func TestCompressFlush(t *testing.T) {
+        w := httptest.NewRecorder()
+        h := Compress(CompressOptions{})(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+                rw.Header().Set("Content-Type", "application/x-ndjson")
+                _, _ = io.WriteString(rw, `{"courier":"Budi"}`+"\n")
+                rw.(http.Flusher).Flush()
+                assert.NotZero(t, w.Body.Len(), "flushed before the handler returns")
+        }))
+        r := httptest.NewRequest(http.MethodGet, "/couriers", nil)
+        r.Header.Set("Accept-Encoding", "gzip")
+        h.ServeHTTP(w, r)
+        assert.True(t, w.Flushed)
+        assert.Equal(t, "gzip", w.Header().Get("Content-Encoding"), "a flushed stream is compressed under MinSize")
+        zr, err := gzip.NewReader(w.Body)
+        require.NoError(t, err)
+        raw, err := ioutil.ReadAll(zr)
+        require.NoError(t, err)
+        assert.Equal(t, `{"courier":"Budi"}`+"\n", string(raw))
+}
@@ -0,0 +1,144 @@
+/*  etag.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 20, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 20/10/26 19:45
+ */
+
+package ruuto
+
+import (
+        "bytes"
+        "crypto/sha1"
+        "encoding/hex"
+        "io"
+        "net/http"
+        "strings"
+        "time"
+
+        "github.com/felixge/httpsnoop"
+)
+
+type ETagOptions struct {
+        Weak bool // generate weak validators, W/"..."
+}
+
+// ETag buffers the successful GET and HEAD responses to set an ETag from
+// the hash of the body, unless the handler set one, and answers 304 Not
+// Modified to a matching If-None-Match, or If-Modified-Since when the
+// handler set Last-Modified. A response that is flushed is sent as it is.
+func ETag(opts ETagOptions) func(next http.Handler) http.Handler {
+        return func(next http.Handler) http.Handler {
+                return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+                        if r.Method != http.MethodGet && r.Method != http.MethodHead {
+                                next.ServeHTTP(w, r)
+                                return
+                        }
+                        ew := &etagWriter{w: w, status: http.StatusOK}
+                        next.ServeHTTP(httpsnoop.Wrap(w, httpsnoop.Hooks{
+                                WriteHeader: func(httpsnoop.WriteHeaderFunc) httpsnoop.WriteHeaderFunc {
+                                        return ew.WriteHeader
+                                },
+                                Write: func(httpsnoop.WriteFunc) httpsnoop.WriteFunc {
+                                        return ew.Write
+                                },
+                                Flush: func(httpsnoop.FlushFunc) httpsnoop.FlushFunc {
+                                        return ew.Flush
+                                },
+                                ReadFrom: func(httpsnoop.ReadFromFunc) httpsnoop.ReadFromFunc {
+                                        return func(src io.Reader) (int64, error) {
+                                                return io.Copy(struct{ io.Writer }{ew}, src)
+                                        }
+                                },
+                        }), r)
+                        if ew.bypass {
+                                return
+                        }
+                        h := w.Header()
+                        if ew.status == http.StatusOK {
+                                if h.Get("ETag") == "" {
+                                        h.Set("ETag", etag(ew.buf.Bytes(), opts.Weak))
+                                }
+                                if notModified(r, h) {
+                                        for _, k := range []string{"Content-Type", "Content-Length", "Content-Encoding"} {
+                                                h.Del(k)
+                                        }
+                                        w.WriteHeader(http.StatusNotModified)
+                                        return
+                                }
+                        }
+                        w.WriteHeader(ew.status)
+                        _, _ = w.Write(ew.buf.Bytes())
+                })
+        }
+}
+
+func etag(body []byte, weak bool) string {
+        sum := sha1.Sum(body)
+        tag := `"` + hex.EncodeToString(sum[:]) + `"`
+        if weak {
+                return "W/" + tag
+        }
+        return tag
+}
+
+// notModified evaluates the conditional headers of RFC 7232,
+// If-Modified-Since is ignored when If-None-Match is present.
+func notModified(r *http.Request, h http.Header) bool {
+        if match := r.Header.Get("If-None-Match"); match != "" {
+                current := strings.TrimPrefix(h.Get("ETag"), "W/")
+                for _, tag := range strings.Split(match, ",") {
+                        tag = strings.TrimSpace(tag)
+                        // weak comparison, W/"x" matches "x"
+                        if tag == "*" || strings.TrimPrefix(tag, "W/") == current {
+                                return true
+                        }
+                }
+                return false
+        }
+        since, err := http.ParseTime(r.Header.Get("If-Modified-Since"))
+        if err != nil {
+                return false
+        }
+        modified, err := http.ParseTime(h.Get("Last-Modified"))
+        if err != nil {
+                return false
+        }
+        return !modified.Truncate(time.Second).After(since)
+}
+
+// etagWriter buffers the response until the handler returns or flushes.
+type etagWriter struct {
+        w      http.ResponseWriter
+        status int
+        buf    bytes.Buffer
+        bypass bool
+}
+
+func (e *etagWriter) WriteHeader(status int) {
+        if e.bypass {
+                return
+        }
+        e.status = status
+}
+
+func (e *etagWriter) Write(p []byte) (int, error) {
+        if e.bypass {
+                return e.w.Write(p)
+        }
+        return e.buf.Write(p)
+}
+
+// Flush sends the buffered response, a streamed response has no ETag.
+func (e *etagWriter) Flush() {
+        if !e.bypass {
+                e.bypass = true
+                e.w.WriteHeader(e.status)
+                _, _ = e.w.Write(e.buf.Bytes())
+                e.buf.Reset()
+        }
+        if f, ok := e.w.(http.Flusher); ok {
+                f.Flush()
+        }
+}
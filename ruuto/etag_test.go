@@ -0,0 +1,156 @@
+/*  etag_test.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 22, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 24/10/26 12:00
+ */
+
+package ruuto
+
+import (
+        "io"
+        "net/http"
+        "net/http/httptest"
+        "testing"
+        "time"
+
+        "github.com/felixge/httpsnoop"
+        "github.com/stretchr/testify/assert"
+        "github.com/stretchr/testify/require"
+        "gitlab.com/suryakencana007/suki/testlog"
+        "go.uber.org/zap/zapcore"
+)
+
+func conditional(h http.Handler, header ...string) *httptest.ResponseRecorder {
+        r := httptest.NewRequest(http.MethodGet, "/couriers", nil)
+        for i := 0; i+1 < len(header); i += 2 {
+                r.Header.Set(header[i], header[i+1])
+        }
+        w := httptest.NewRecorder()
+        h.ServeHTTP(w, r)
+        return w
+}
+
+func TestETagIfNoneMatch(t *testing.T) {
+        h := ETag(ETagOptions{})(courierHandler("application/json", courierJSON))
+        w := conditional(h)
+        require.Equal(t, http.StatusOK, w.Code)
+        tag := w.Header().Get("ETag")
+        require.NotEmpty(t, tag)
+        assert.Equal(t, courierJSON, w.Body.String())
+
+        w = conditional(h, "If-None-Match", `"other", `+tag)
+        assert.Equal(t, http.StatusNotModified, w.Code)
+        assert.Zero(t, w.Body.Len())
+        assert.Empty(t, w.Header().Get("Content-Type"))
+
+        w = conditional(h, "If-None-Match", "W/"+tag)
+        assert.Equal(t, http.StatusNotModified, w.Code, "weak comparison")
+
+        w = conditional(h, "If-None-Match", `"other"`)
+        assert.Equal(t, http.StatusOK, w.Code)
+
+        weak := ETag(ETagOptions{Weak: true})(courierHandler("application/json", courierJSON))
+        assert.Equal(t, "W/"+tag, conditional(weak).Header().Get("ETag"))
+}
+
+func TestETagIfModifiedSince(t *testing.T) {
+        modified := time.Date(2026, 10, 20, 19, 45, 0, 0, time.UTC)
+        h := ETag(ETagOptions{})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+                w.Header().Set("Last-Modified", modified.Format(http.TimeFormat))
+                _, _ = io.WriteString(w, courierJSON)
+        }))
+
+        w := conditional(h, "If-Modified-Since", modified.Format(http.TimeFormat))
+        assert.Equal(t, http.StatusNotModified, w.Code)
+        assert.Zero(t, w.Body.Len())
+
+        w = conditional(h, "If-Modified-Since", modified.Add(-time.Hour).Format(http.TimeFormat))
+        assert.Equal(t, http.StatusOK, w.Code)
+        assert.Equal(t, courierJSON, w.Body.String())
+
+        w = conditional(h, "If-Modified-Since", modified.Format(http.TimeFormat), "If-None-Match", `"other"`)
+        assert.Equal(t, http.StatusOK, w.Code, "If-None-Match wins")
+}
+
+func TestETagWithoutBody(t *testing.T) {
+        h := ETag(ETagOptions{})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+                w.WriteHeader(http.StatusNoContent)
+        }))
+        w := conditional(h)
+        assert.Equal(t, http.StatusNoContent, w.Code)
+        assert.Empty(t, w.Header().Get("ETag"))
+        assert.Zero(t, w.Body.Len())
+
+        post := ETag(ETagOptions{})(courierHandler("application/json", courierJSON))
+        r := httptest.NewRequest(http.MethodPost, "/couriers", nil)
+        rec := httptest.NewRecorder()
+        post.ServeHTTP(rec, r)
+        assert.Empty(t, rec.Header().Get("ETag"), "only GET and HEAD")
+}
+
+func TestETagFlush(t *testing.T) {
+        h := ETag(ETagOptions{})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+                _, _ = io.WriteString(w, "first\n")
+                w.(http.Flusher).Flush()
+                _, _ = io.WriteString(w, "second\n")
+        }))
+        w := conditional(h)
+        assert.True(t, w.Flushed)
+        assert.Empty(t, w.Header().Get("ETag"), "a streamed response has no ETag")
+        assert.Equal(t, "first\nsecond\n", w.Body.String())
+}
+
+func TestCompressETag(t *testing.T) {
+        h := Compress(CompressOptions{})(ETag(ETagOptions{})(courierHandler("application/json", courierJSON)))
+        plain := conditional(ETag(ETagOptions{})(courierHandler("application/json", courierJSON)))
+        tag := plain.Header().Get("ETag")
+
+        w := conditional(h, "Accept-Encoding", "gzip")
+        assert.Equal(t, "gzip", w.Header().Get("Content-Encoding"))
+        assert.Equal(t, "W/"+tag, w.Header().Get("ETag"), "the compressed body has a weak validator")
+
+        w = conditional(h, "Accept-Encoding", "gzip", "If-None-Match", w.Header().Get("ETag"))
+        assert.Equal(t, http.StatusNotModified, w.Code)
+        assert.Empty(t, w.Header().Get("Content-Encoding"))
+        assert.Zero(t, w.Body.Len())
+}
+
+// TestCompressMetrics captures the metrics as ruuto.Logger does,
+// the status of the inner handler reaches the snooped writer.
+func TestCompressMetrics(t *testing.T) {
+        h := Compress(CompressOptions{})(ETag(ETagOptions{})(courierHandler("application/json", courierJSON)))
+
+        r := httptest.NewRequest(http.MethodGet, "/couriers", nil)
+        r.Header.Set("Accept-Encoding", "gzip")
+        w := httptest.NewRecorder()
+        m := httpsnoop.CaptureMetrics(h, w, r)
+        require.Equal(t, http.StatusOK, w.Code)
+        assert.Equal(t, http.StatusOK, m.Code)
+        assert.EqualValues(t, w.Body.Len(), m.Written)
+
+        r = httptest.NewRequest(http.MethodGet, "/couriers", nil)
+        r.Header.Set("Accept-Encoding", "gzip")
+        r.Header.Set("If-None-Match", w.Header().Get("ETag"))
+        m = httpsnoop.CaptureMetrics(h, httptest.NewRecorder(), r)
+        assert.Equal(t, http.StatusNotModified, m.Code)
+        assert.Zero(t, m.Written)
+}
+
+func TestLoggerMetrics(t *testing.T) {
+        logs := testlog.New(t)
+        h := Logger()(Compress(CompressOptions{})(ETag(ETagOptions{})(courierHandler("application/json", courierJSON))))
+
+        w := conditional(h, "Accept-Encoding", "gzip")
+        require.Equal(t, http.StatusOK, w.Code)
+        entries := logs.Find(zapcore.InfoLevel, "Completed handling request")
+        require.Len(t, entries, 1)
+        assert.EqualValues(t, http.StatusOK, entries[0].Fields["code"])
+
+        logs.Reset()
+        conditional(h, "Accept-Encoding", "gzip", "If-None-Match", w.Header().Get("ETag"))
+        entries = logs.Find(zapcore.InfoLevel, "Completed handling request")
+        require.Len(t, entries, 1)
+        assert.EqualValues(t, http.StatusNotModified, entries[0].Fields["code"])
+}
@@ -0,0 +1,28 @@
+/*  locale.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 21, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 21/10/26 10:05
+ */
+
+package ruuto
+
+import (
+        "net/http"
+
+        "gitlab.com/suryakencana007/suki"
+)
+
+// Locale negotiates the locale of the messages from the query parameter
+// param, e.g. "lang", or the Accept-Language header of the request.
+func Locale(param string) func(next http.Handler) http.Handler {
+        return func(next http.Handler) http.Handler {
+                return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+                        locale := suki.NegotiateLocale(r, param)
+                        w.Header().Set("Content-Language", locale)
+                        w.Header().Add("Vary", "Accept-Language")
+                        next.ServeHTTP(w, r.WithContext(suki.WithLocale(r.Context(), locale)))
+                })
+        }
+}
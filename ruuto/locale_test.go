@@ -0,0 +1,49 @@
+/*  locale_test.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 24, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 24/10/26 12:10
+ */
+
+package ruuto
+
+import (
+        "net/http"
+        "net/http/httptest"
+        "testing"
+
+        "github.com/stretchr/testify/assert"
+        "gitlab.com/suryakencana007/suki"
+)
+
+func TestLocale(t *testing.T) {
+        tests := []struct {
+                name     string
+                target   string
+                language string
+                locale   string
+        }{
+                {name: "Accept-Language", target: "/", language: "fr;q=0.9, id-ID, en;q=0.8", locale: "id"},
+                {name: "Query Parameter", target: "/?lang=id", language: "en", locale: "id"},
+                {name: "Default Bundle", target: "/?lang=fr", language: "fr-FR, de;q=0.5", locale: suki.DefaultLocale},
+        }
+        for _, tt := range tests {
+                t.Run(tt.name, func(t *testing.T) {
+                        var locale string
+                        h := Locale("lang")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+                                locale = suki.Locale(r.Context())
+                                suki.WriteError(w, r, suki.ErrNotFound)
+                        }))
+                        r := httptest.NewRequest(http.MethodGet, tt.target, nil)
+                        r.Header.Set("Accept-Language", tt.language)
+                        w := httptest.NewRecorder()
+                        h.ServeHTTP(w, r)
+
+                        assert.Equal(t, tt.locale, locale, "the locale of the request context")
+                        assert.Equal(t, tt.locale, w.Header().Get("Content-Language"))
+                        assert.Equal(t, "Accept-Language", w.Header().Get("Vary"))
+                        assert.Contains(t, w.Body.String(), suki.StatusTextLocale(tt.locale, http.StatusNotFound))
+                })
+        }
+}
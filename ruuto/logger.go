@@ -16,15 +16,18 @@ import (
         "gitlab.com/suryakencana007/suki"
 )
 
+// Logger logs the start and the end of the requests with the logger
+// of the request context, see RequestID.
 func Logger() func(next http.Handler) http.Handler {
         return func(next http.Handler) http.Handler {
                 return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-                        suki.With(
+                        log := suki.FromContext(r.Context())
+                        log.With(
                                 suki.Field("method", r.Method),
                                 suki.Field("path", r.URL.Path),
                         ).Debug("Started Request")
                         m := httpsnoop.CaptureMetrics(next, w, r)
-                        suki.With(
+                        log.With(
                                 suki.Field("code", m.Code),
                                 suki.Field("duration", int(m.Duration/time.Millisecond)),
                                 suki.Field("duration-fmt", m.Duration.String()),
@@ -0,0 +1,25 @@
+/*  problem.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 19, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 19/10/26 14:40
+ */
+
+package ruuto
+
+import (
+        "net/http"
+
+        "gitlab.com/suryakencana007/suki"
+)
+
+// ProblemDetails writes the error responses of the routes as
+// RFC 7807 application/problem+json instead of the suki envelope.
+func ProblemDetails() func(next http.Handler) http.Handler {
+        return func(next http.Handler) http.Handler {
+                return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+                        next.ServeHTTP(w, r.WithContext(suki.WithProblem(r.Context())))
+                })
+        }
+}
@@ -0,0 +1,59 @@
+/*  problem_test.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 24, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 24/10/26 10:10
+ */
+
+package ruuto
+
+import (
+        "encoding/json"
+        "net/http"
+        "net/http/httptest"
+        "testing"
+
+        "github.com/stretchr/testify/assert"
+        "github.com/stretchr/testify/require"
+        "gitlab.com/suryakencana007/suki"
+)
+
+func notFound(wants *bool) http.HandlerFunc {
+        return func(w http.ResponseWriter, r *http.Request) {
+                *wants = suki.WantsProblem(r)
+                suki.WriteError(w, r, suki.ErrNotFound)
+        }
+}
+
+func TestProblemDetails(t *testing.T) {
+        var wants bool
+        h := ProblemDetails()(notFound(&wants))
+        w := httptest.NewRecorder()
+        h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/couriers/7", nil))
+
+        assert.True(t, wants, "the context flag is set")
+        assert.Equal(t, http.StatusNotFound, w.Code)
+        assert.Equal(t, suki.ContentTypeProblem, w.Header().Get("Content-Type"))
+        var problem map[string]interface{}
+        require.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+        assert.EqualValues(t, http.StatusNotFound, problem["status"])
+}
+
+func TestWantsProblemAccept(t *testing.T) {
+        var wants bool
+        h := notFound(&wants)
+
+        w := httptest.NewRecorder()
+        h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/couriers/7", nil))
+        assert.False(t, wants)
+        assert.NotEqual(t, suki.ContentTypeProblem, w.Header().Get("Content-Type"), "the suki envelope")
+
+        r := httptest.NewRequest(http.MethodGet, "/couriers/7", nil)
+        r.Header.Set("Accept", "application/problem+json, application/json;q=0.9")
+        w = httptest.NewRecorder()
+        h.ServeHTTP(w, r)
+        assert.True(t, wants, "asked by the Accept header")
+        assert.Equal(t, http.StatusNotFound, w.Code)
+        assert.Equal(t, suki.ContentTypeProblem, w.Header().Get("Content-Type"))
+}
@@ -0,0 +1,96 @@
+/*  requestid.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 21, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 21/10/26 14:30
+ */
+
+package ruuto
+
+import (
+        "net/http"
+        "strings"
+
+        "gitlab.com/suryakencana007/suki"
+)
+
+type RequestIDOptions struct {
+        Header    string                       // header of the request id, X-Request-ID by default
+        Principal func(r *http.Request) string // the user of the request, logged as principal when not empty
+}
+
+// RequestID propagates the request id of the request header, or generates
+// one, and sets it on the response. The logger of the request context logs
+// it as request_id with the trace_id and span_id of a W3C traceparent
+// header and the principal, so suki.FromContext correlates the lines
+// logged while handling the request. Use it before Logger.
+func RequestID(opts RequestIDOptions) func(next http.Handler) http.Handler {
+        if opts.Header == "" {
+                opts.Header = "X-Request-ID"
+        }
+        return func(next http.Handler) http.Handler {
+                return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+                        id := r.Header.Get(opts.Header)
+                        if !validRequestID(id) {
+                                id = suki.NewRequestID()
+                        }
+                        w.Header().Set(opts.Header, id)
+                        fields := []interface{}{suki.Field("request_id", id)}
+                        if traceID, spanID, ok := traceParent(r.Header.Get("traceparent")); ok {
+                                fields = append(fields,
+                                        suki.Field("trace_id", traceID),
+                                        suki.Field("span_id", spanID),
+                                )
+                        }
+                        if opts.Principal != nil {
+                                if principal := opts.Principal(r); principal != "" {
+                                        fields = append(fields, suki.Field("principal", principal))
+                                }
+                        }
+                        ctx := suki.WithFields(suki.WithRequestID(r.Context(), id), fields...)
+                        next.ServeHTTP(w, r.WithContext(ctx))
+                })
+        }
+}
+
+// validRequestID keeps a client id short and printable,
+// it ends up in the logs and the response headers.
+func validRequestID(id string) bool {
+        if id == "" || len(id) > 128 {
+                return false
+        }
+        for _, c := range id {
+                if c < 0x21 || c > 0x7e {
+                        return false
+                }
+        }
+        return true
+}
+
+// traceParent parses the version 00 of a W3C traceparent header,
+// "00-<trace id>-<span id>-<flags>".
+func traceParent(header string) (traceID, spanID string, ok bool) {
+        parts := strings.Split(strings.TrimSpace(header), "-")
+        if len(parts) != 4 || parts[0] != "00" || !lowerHex(parts[1], 32) ||
+                !lowerHex(parts[2], 16) || !lowerHex(parts[3], 2) {
+                return "", "", false
+        }
+        // all zero ids are invalid
+        if strings.Trim(parts[1], "0") == "" || strings.Trim(parts[2], "0") == "" {
+                return "", "", false
+        }
+        return parts[1], parts[2], true
+}
+
+func lowerHex(s string, n int) bool {
+        if len(s) != n {
+                return false
+        }
+        for _, c := range s {
+                if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+                        return false
+                }
+        }
+        return true
+}
@@ -0,0 +1,59 @@
+/*  requestid_test.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 24, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 24/10/26 12:40
+ */
+
+package ruuto
+
+import (
+        "net/http"
+        "net/http/httptest"
+        "testing"
+
+        "github.com/stretchr/testify/assert"
+        "github.com/stretchr/testify/require"
+        "gitlab.com/suryakencana007/suki"
+        "gitlab.com/suryakencana007/suki/testlog"
+        "go.uber.org/zap/zapcore"
+)
+
+func TestRequestID(t *testing.T) {
+        logs := testlog.New(t)
+        var id string
+        h := RequestID(RequestIDOptions{
+                Principal: func(r *http.Request) string { return r.Header.Get("X-User") },
+        })(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+                id = suki.RequestID(r.Context())
+                suki.FromContext(r.Context()).Info("assigning courier")
+        }))
+
+        r := httptest.NewRequest(http.MethodGet, "/couriers", nil)
+        r.Header.Set("X-Request-ID", "order-17")
+        r.Header.Set("X-User", "nanang")
+        r.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+        w := httptest.NewRecorder()
+        h.ServeHTTP(w, r)
+        assert.Equal(t, "order-17", id, "the incoming id")
+        assert.Equal(t, "order-17", w.Header().Get("X-Request-ID"))
+        logs.AssertLogged(zapcore.InfoLevel, "assigning courier",
+                "request_id", "order-17",
+                "trace_id", "4bf92f3577b34da6a3ce929d0e0e4736",
+                "span_id", "00f067aa0ba902b7",
+                "principal", "nanang",
+        )
+
+        logs.Reset()
+        r = httptest.NewRequest(http.MethodGet, "/couriers", nil)
+        r.Header.Set("X-Request-ID", "bad id\n")
+        w = httptest.NewRecorder()
+        h.ServeHTTP(w, r)
+        require.Len(t, id, 32, "a generated id")
+        assert.Equal(t, id, w.Header().Get("X-Request-ID"))
+        entries := logs.Find(zapcore.InfoLevel, "assigning courier")
+        require.Len(t, entries, 1)
+        assert.Equal(t, id, entries[0].Fields["request_id"])
+        assert.NotContains(t, entries[0].Fields, "principal")
+}
@@ -0,0 +1,176 @@
+/*  sampler.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 21, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 23/10/26 10:20
+ */
+
+package suki
+
+import (
+        "sync"
+        "time"
+
+        "go.uber.org/zap"
+        "go.uber.org/zap/zapcore"
+)
+
+// Sampling logs the first entries with the same level and message
+// every tick, then every Thereafter-th of them, 0 drops the rest.
+type Sampling struct {
+        Tick         time.Duration // time.Second by default
+        First        int
+        Thereafter   int
+        Report       time.Duration // report the dropped entries at most every Report, time.Minute by default
+        ExemptErrors bool          // never sample Error and above
+        Exempt       []string      // messages never sampled
+}
+
+// SampledMessage is the message of the Warn entry reporting
+// the entries dropped by the sampling.
+const SampledMessage = "log entries dropped by sampling"
+
+// SampleCore samples the entries of core, the dropped entries are counted by
+// message and reported once Report has passed, by a timer when no entry is
+// logged meanwhile, and on Sync.
+func SampleCore(core Core, opts Sampling) Core {
+        if opts.Tick <= 0 {
+                opts.Tick = time.Second
+        }
+        if opts.Report <= 0 {
+                opts.Report = time.Minute
+        }
+        exempt := make(map[string]bool, len(opts.Exempt))
+        for _, msg := range opts.Exempt {
+                exempt[msg] = true
+        }
+        s := &sampler{
+                opts:    opts,
+                exempt:  exempt,
+                root:    core,
+                counts:  make(map[sampleKey]*sampleCount),
+                dropped: make(map[string]int64),
+                now:     time.Now,
+        }
+        s.reported = s.now()
+        return &samplerCore{Core: core, sampler: s}
+}
+
+type sampleKey struct {
+        level zapcore.Level
+        msg   string
+}
+
+type sampleCount struct {
+        n       int
+        resetAt time.Time
+}
+
+// sampler is shared by a core and the children of its With.
+type sampler struct {
+        opts   Sampling
+        exempt map[string]bool
+        root   Core
+
+        mu       sync.Mutex
+        counts   map[sampleKey]*sampleCount
+        dropped  map[string]int64
+        reported time.Time
+        timer    *time.Timer // pending report of the dropped entries
+        now      func() time.Time
+}
+
+type samplerCore struct {
+        zapcore.Core
+        sampler *sampler
+}
+
+func (c *samplerCore) With(fields []ZapField) Core {
+        return &samplerCore{Core: c.Core.With(fields), sampler: c.sampler}
+}
+
+func (c *samplerCore) Check(e zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+        if !c.Enabled(e.Level) {
+                return ce
+        }
+        if c.sampler.sample(e) {
+                return c.Core.Check(e, ce)
+        }
+        return ce
+}
+
+// Sync reports the dropped entries before syncing core.
+func (c *samplerCore) Sync() error {
+        c.sampler.report(c.sampler.now(), true)
+        return c.Core.Sync()
+}
+
+// sample reports whether the entry is logged, it reports the dropped
+// entries first when it is time.
+func (s *sampler) sample(e zapcore.Entry) bool {
+        if (s.opts.ExemptErrors && e.Level >= zapcore.ErrorLevel) || s.exempt[e.Message] {
+                s.report(e.Time, false)
+                return true
+        }
+        s.mu.Lock()
+        now := s.now()
+        key := sampleKey{level: e.Level, msg: e.Message}
+        count, ok := s.counts[key]
+        if !ok || !now.Before(count.resetAt) {
+                count = &sampleCount{resetAt: now.Add(s.opts.Tick)}
+                s.counts[key] = count
+                // forget the messages of the past ticks
+                if len(s.counts) > 4096 {
+                        for k, c := range s.counts {
+                                if !now.Before(c.resetAt) {
+                                        delete(s.counts, k)
+                                }
+                        }
+                }
+        }
+        count.n++
+        n := count.n
+        logged := n <= s.opts.First ||
+                (s.opts.Thereafter > 0 && (n-s.opts.First)%s.opts.Thereafter == 0)
+        if !logged {
+                s.dropped[e.Message]++
+                if s.timer == nil {
+                        s.timer = time.AfterFunc(s.opts.Report-now.Sub(s.reported), s.flush)
+                }
+        }
+        s.mu.Unlock()
+        s.report(e.Time, false)
+        return logged
+}
+
+// flush reports the dropped entries when the timer fires.
+func (s *sampler) flush() {
+        s.report(s.now(), true)
+}
+
+// report logs the dropped entries once Report has passed since the last
+// report, or right away when forced.
+func (s *sampler) report(at time.Time, force bool) {
+        s.mu.Lock()
+        now := s.now()
+        if len(s.dropped) == 0 || (!force && now.Sub(s.reported) < s.opts.Report) {
+                s.mu.Unlock()
+                return
+        }
+        if s.timer != nil {
+                s.timer.Stop()
+                s.timer = nil
+        }
+        dropped, total := s.dropped, int64(0)
+        for _, n := range dropped {
+                total += n
+        }
+        s.dropped, s.reported = make(map[string]int64), now
+        s.mu.Unlock()
+
+        e := zapcore.Entry{Level: zapcore.WarnLevel, Time: at, Message: SampledMessage}
+        if ce := s.root.Check(e, nil); ce != nil {
+                ce.Write(zap.Int64("dropped", total), zap.Any("messages", dropped))
+        }
+}
@@ -0,0 +1,115 @@
+/*  sampler_test.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 21, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 23/10/26 10:30
+ */
+
+package suki
+
+import (
+        "testing"
+        "time"
+
+        "github.com/stretchr/testify/assert"
+        "go.uber.org/zap"
+        "go.uber.org/zap/zapcore"
+        "go.uber.org/zap/zaptest/observer"
+)
+
+func sampledZap(opts Sampling) (*zapLog, *observer.ObservedLogs, *time.Time) {
+        obs, logs := observer.New(zapcore.DebugLevel)
+        core := SampleCore(obs, opts).(*samplerCore)
+        clock := time.Date(2026, 10, 21, 19, 0, 0, 0, time.UTC)
+        core.sampler.now = func() time.Time { return clock }
+        core.sampler.reported = clock
+        return &zapLog{logger: zap.New(core), noCaller: true}, logs, &clock
+}
+
+func messages(logs *observer.ObservedLogs) []string {
+        msgs := make([]string, 0)
+        for _, e := range logs.TakeAll() {
+                msgs = append(msgs, e.Message)
+        }
+        return msgs
+}
+
+func TestSampling(t *testing.T) {
+        log, logs, clock := sampledZap(Sampling{First: 2, Thereafter: 3})
+        for i := 0; i < 8; i++ {
+                log.Warn("query failed")
+        }
+        log.Info("query failed")
+        // logged 1, 2, 5, 8 of the Warn and the first Info
+        assert.Equal(t, []string{"query failed", "query failed", "query failed", "query failed", "query failed"}, messages(logs))
+
+        *clock = clock.Add(time.Second)
+        log.With(String("db", "orders")).Warn("query failed")
+        assert.Equal(t, []string{"query failed"}, messages(logs), "a new tick logs the first again")
+}
+
+func TestSamplingReport(t *testing.T) {
+        log, logs, clock := sampledZap(Sampling{First: 1, Report: 10 * time.Second})
+        for i := 0; i < 5; i++ {
+                log.Warn("query failed")
+                log.Debug("result not found")
+        }
+        assert.Len(t, messages(logs), 2)
+
+        *clock = clock.Add(10 * time.Second)
+        log.Info("received work order")
+        entries := logs.TakeAll()
+        assert.Len(t, entries, 2)
+        assert.Equal(t, SampledMessage, entries[0].Message)
+        assert.Equal(t, zapcore.WarnLevel, entries[0].Level)
+        assert.Equal(t, map[string]interface{}{
+                "dropped":  int64(8),
+                "messages": map[string]int64{"query failed": 4, "result not found": 4},
+        }, entries[0].ContextMap())
+        assert.Equal(t, "received work order", entries[1].Message)
+
+        *clock = clock.Add(time.Minute)
+        log.Info("starting work")
+        assert.Equal(t, []string{"starting work"}, messages(logs), "nothing dropped, nothing reported")
+}
+
+func TestSamplingReportTimer(t *testing.T) {
+        obs, logs := observer.New(zapcore.DebugLevel)
+        log := &zapLog{logger: zap.New(SampleCore(obs, Sampling{First: 1, Report: 20 * time.Millisecond})), noCaller: true}
+        for i := 0; i < 3; i++ {
+                log.Warn("query failed")
+        }
+        assert.Eventually(t, func() bool {
+                return logs.FilterMessage(SampledMessage).Len() == 1
+        }, time.Second, 5*time.Millisecond, "reported without a later entry")
+        assert.Equal(t, int64(2), logs.FilterMessage(SampledMessage).All()[0].ContextMap()["dropped"])
+}
+
+func TestSamplingReportSync(t *testing.T) {
+        log, logs, _ := sampledZap(Sampling{First: 1, Report: time.Hour})
+        log.Warn("query failed")
+        log.Warn("query failed")
+        assert.Equal(t, []string{"query failed"}, messages(logs))
+
+        assert.NoError(t, log.logger.Sync())
+        entries := logs.TakeAll()
+        assert.Len(t, entries, 1)
+        assert.Equal(t, SampledMessage, entries[0].Message)
+        assert.Equal(t, int64(1), entries[0].ContextMap()["dropped"])
+
+        assert.NoError(t, log.logger.Sync())
+        assert.Empty(t, messages(logs), "nothing dropped since")
+}
+
+func TestSamplingExempt(t *testing.T) {
+        log, logs, _ := sampledZap(Sampling{First: 1, ExemptErrors: true, Exempt: []string{"order delivered"}})
+        for i := 0; i < 3; i++ {
+                log.Error("work failed")
+                log.Info("order delivered")
+                log.Info("received work order")
+        }
+        assert.Len(t, logs.FilterMessage("work failed").All(), 3)
+        assert.Len(t, logs.FilterMessage("order delivered").All(), 3)
+        assert.Len(t, logs.FilterMessage("received work order").All(), 1)
+}
@@ -0,0 +1,239 @@
+/*  slog.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 21, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 23/10/26 09:30
+ */
+
+package suki
+
+import (
+        "context"
+        "log/slog"
+        "os"
+        "runtime"
+        "time"
+
+        "go.uber.org/zap/zapcore"
+)
+
+// The levels of Fatal and Panic for a slog.Logger, above slog.LevelError.
+const (
+        slogLevelFatal = slog.LevelError + 4
+        slogLevelPanic = slog.LevelError + 8
+)
+
+func slogToZap(l slog.Level) zapcore.Level {
+        switch {
+        case l < slog.LevelInfo:
+                return zapcore.DebugLevel
+        case l < slog.LevelWarn:
+                return zapcore.InfoLevel
+        case l < slog.LevelError:
+                return zapcore.WarnLevel
+        default:
+                return zapcore.ErrorLevel
+        }
+}
+
+func zapToSlog(l zapcore.Level) slog.Level {
+        switch {
+        case l <= zapcore.DebugLevel:
+                return slog.LevelDebug
+        case l == zapcore.InfoLevel:
+                return slog.LevelInfo
+        case l == zapcore.WarnLevel:
+                return slog.LevelWarn
+        case l == zapcore.FatalLevel:
+                return slogLevelFatal
+        case l == zapcore.PanicLevel:
+                return slogLevelPanic
+        default:
+                return slog.LevelError
+        }
+}
+
+// slogHandler is a slog.Handler logging with a suki logger.
+type slogHandler struct {
+        logger Logging
+        fields []interface{}
+        group  string
+}
+
+// NewSlogHandler returns a slog.Handler writing the records to l, nil for
+// the logger of the context of each record, suki.FromContext. The groups
+// prefix the keys, e.g. "http.method".
+//
+//	slog.SetDefault(slog.New(suki.NewSlogHandler(nil)))
+func NewSlogHandler(l Logging) slog.Handler {
+        return &slogHandler{logger: l}
+}
+
+func (h *slogHandler) Enabled(_ context.Context, l slog.Level) bool {
+        return level.Enabled(slogToZap(l))
+}
+
+func (h *slogHandler) Handle(ctx context.Context, r slog.Record) error {
+        l := h.logger
+        if l == nil {
+                l = FromContext(ctx)
+        }
+        fields := make([]interface{}, len(h.fields), len(h.fields)+r.NumAttrs())
+        copy(fields, h.fields)
+        r.Attrs(func(a slog.Attr) bool {
+                fields = appendAttr(fields, h.group, a)
+                return true
+        })
+        logPC(l, slogToZap(r.Level), r.PC, r.Message, fields)
+        return nil
+}
+
+func (h *slogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+        fields := make([]interface{}, len(h.fields), len(h.fields)+len(attrs))
+        copy(fields, h.fields)
+        for _, a := range attrs {
+                fields = appendAttr(fields, h.group, a)
+        }
+        return &slogHandler{logger: h.logger, fields: fields, group: h.group}
+}
+
+func (h *slogHandler) WithGroup(name string) slog.Handler {
+        if name == "" {
+                return h
+        }
+        return &slogHandler{logger: h.logger, fields: h.fields, group: h.group + name + "."}
+}
+
+// appendAttr appends the field of the attribute, a group is flattened.
+func appendAttr(fields []interface{}, prefix string, a slog.Attr) []interface{} {
+        a.Value = a.Value.Resolve()
+        if a.Equal(slog.Attr{}) {
+                return fields
+        }
+        key := prefix + a.Key
+        switch a.Value.Kind() {
+        case slog.KindString:
+                return append(fields, String(key, a.Value.String()))
+        case slog.KindInt64:
+                return append(fields, Int64(key, a.Value.Int64()))
+        case slog.KindUint64:
+                return append(fields, Uint64(key, a.Value.Uint64()))
+        case slog.KindFloat64:
+                return append(fields, Float64(key, a.Value.Float64()))
+        case slog.KindBool:
+                return append(fields, Bool(key, a.Value.Bool()))
+        case slog.KindDuration:
+                return append(fields, Duration(key, a.Value.Duration()))
+        case slog.KindTime:
+                return append(fields, Time(key, a.Value.Time()))
+        case slog.KindGroup:
+                if a.Key != "" {
+                        prefix = key + "."
+                }
+                for _, g := range a.Value.Group() {
+                        fields = appendAttr(fields, prefix, g)
+                }
+                return fields
+        default:
+                if err, ok := a.Value.Any().(error); ok {
+                        return append(fields, NamedErr(key, err))
+                }
+                return append(fields, Any(key, a.Value.Any()))
+        }
+}
+
+// slogLogging is a Logging writing to a slog.Logger.
+type slogLogging struct {
+        logger *slog.Logger
+}
+
+// FromSlog returns a Logging writing to l, the suki fields become
+// attributes, Fatal exits and Panic panics after logging above
+// slog.LevelError.
+func FromSlog(l *slog.Logger) Logging {
+        return &slogLogging{logger: l}
+}
+
+func (s *slogLogging) With(fields ...interface{}) Logging {
+        return &slogLogging{logger: s.logger.With(slogArgs(fields)...)}
+}
+
+func (s *slogLogging) Debug(msg string, fields ...interface{}) {
+        s.logSkip(zapcore.DebugLevel, 1, msg, fields)
+}
+
+func (s *slogLogging) Info(msg string, fields ...interface{}) {
+        s.logSkip(zapcore.InfoLevel, 1, msg, fields)
+}
+
+func (s *slogLogging) Warn(msg string, fields ...interface{}) {
+        s.logSkip(zapcore.WarnLevel, 1, msg, fields)
+}
+
+func (s *slogLogging) Error(msg string, fields ...interface{}) {
+        s.logSkip(zapcore.ErrorLevel, 1, msg, fields)
+}
+
+func (s *slogLogging) Fatal(msg string, fields ...interface{}) {
+        s.logSkip(zapcore.FatalLevel, 1, msg, fields)
+}
+
+func (s *slogLogging) Panic(msg string, fields ...interface{}) {
+        s.logSkip(zapcore.PanicLevel, 1, msg, fields)
+}
+
+func (s *slogLogging) Field(key string, value interface{}) interface{} {
+        return slog.Any(key, value)
+}
+
+func (s *slogLogging) logSkip(lvl zapcore.Level, skip int, msg string, fields []interface{}) {
+        var pcs [1]uintptr
+        runtime.Callers(skip+2, pcs[:])
+        s.logPC(lvl, pcs[0], msg, fields)
+}
+
+// logPC writes the record with the source of pc, then exits for Fatal and
+// panics for Panic.
+func (s *slogLogging) logPC(lvl zapcore.Level, pc uintptr, msg string, fields []interface{}) {
+        ctx := context.Background()
+        if l := zapToSlog(lvl); s.logger.Enabled(ctx, l) {
+                r := slog.NewRecord(time.Now(), l, msg, pc)
+                r.Add(slogArgs(fields)...)
+                _ = s.logger.Handler().Handle(ctx, r)
+        }
+        switch lvl {
+        case zapcore.FatalLevel:
+                os.Exit(1)
+        case zapcore.PanicLevel:
+                panic(msg)
+        }
+}
+
+// slogArgs converts the suki fields to attributes,
+// the other values are left to slog.
+func slogArgs(fields []interface{}) []interface{} {
+        args := make([]interface{}, 0, len(fields))
+        for _, f := range fields {
+                switch field := f.(type) {
+                case ZapField:
+                        args = appendZapAttr(args, field)
+                case []ZapField:
+                        for _, zf := range field {
+                                args = appendZapAttr(args, zf)
+                        }
+                default:
+                        args = append(args, f)
+                }
+        }
+        return args
+}
+
+func appendZapAttr(args []interface{}, f ZapField) []interface{} {
+        enc := zapcore.NewMapObjectEncoder()
+        f.AddTo(enc)
+        for k, v := range enc.Fields {
+                args = append(args, slog.Any(k, v))
+        }
+        return args
+}
@@ -0,0 +1,83 @@
+/*  slog_test.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 21, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 23/10/26 09:50
+ */
+
+package suki
+
+import (
+        "bytes"
+        "context"
+        "encoding/json"
+        "errors"
+        "log/slog"
+        "runtime"
+        "testing"
+        "time"
+
+        "github.com/stretchr/testify/assert"
+        "go.uber.org/zap/zapcore"
+)
+
+func TestSlogHandler(t *testing.T) {
+        l, logs := observedZap()
+        sl := slog.New(NewSlogHandler(l)).With("request_id", "a1b2").WithGroup("http")
+        sl.Info("request", "method", "GET", slog.Int("code", 200), slog.Group("took", "ms", 12))
+        sl.Warn("slow request", "after", time.Second)
+        sl.Error("request failed", "error", errors.New("late"))
+        sl.Debug("headers")
+
+        entries := logs.AllUntimed()
+        assert.Len(t, entries, 4)
+        assert.Equal(t, map[string]interface{}{
+                "request_id":   "a1b2",
+                "http.method":  "GET",
+                "http.code":    int64(200),
+                "http.took.ms": int64(12),
+        }, entries[0].ContextMap())
+        assert.Equal(t, zapcore.WarnLevel, entries[1].Level)
+        assert.Equal(t, "late", entries[2].ContextMap()["http.error"])
+        assert.Equal(t, zapcore.DebugLevel, entries[3].Level)
+}
+
+func TestSlogHandlerContext(t *testing.T) {
+        l, logs := observedZap()
+        ctx := WithLogger(context.Background(), l.With(String("request_id", "a1b2")))
+        slog.New(NewSlogHandler(nil)).InfoContext(ctx, "received work order")
+        assert.Equal(t, map[string]interface{}{"request_id": "a1b2"}, logs.AllUntimed()[0].ContextMap())
+}
+
+func TestSlogHandlerCaller(t *testing.T) {
+        l, logs := callerZap()
+        sl := slog.New(NewSlogHandler(l))
+
+        _, _, line, _ := runtime.Caller(0)
+        sl.Info("received work order")
+        sl.With("request_id", "a1b2").Warn("slow request")
+        slog.NewLogLogger(sl.Handler(), slog.LevelError).Print("request failed")
+        assertCaller(t, logs, "slog_test.go", line, "TestSlogHandlerCaller")
+}
+
+func TestFromSlog(t *testing.T) {
+        var buf bytes.Buffer
+        l := FromSlog(slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug, AddSource: true})))
+        l.With(String("courier", "phil")).Info("order delivered", Int("items", 3), Field("paid", true), "zone", "south")
+
+        var line map[string]interface{}
+        assert.NoError(t, json.Unmarshal(buf.Bytes(), &line))
+        assert.Equal(t, "order delivered", line["msg"])
+        assert.Equal(t, "INFO", line["level"])
+        assert.Equal(t, "phil", line["courier"])
+        assert.Equal(t, float64(3), line["items"])
+        assert.Equal(t, true, line["paid"])
+        assert.Equal(t, "south", line["zone"])
+        assert.Equal(t, "gitlab.com/suryakencana007/suki.TestFromSlog", line["source"].(map[string]interface{})["function"])
+
+        assert.Panics(t, func() {
+                l.Panic("failed to do work")
+        })
+        assert.Contains(t, buf.String(), `"level":"ERROR+8"`)
+}
@@ -0,0 +1,73 @@
+/*  mask.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 21, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 21/10/26 16:40
+ */
+
+package sqlx
+
+import (
+        "regexp"
+        "strconv"
+        "strings"
+
+        "gitlab.com/suryakencana007/suki"
+)
+
+var (
+        // column = $1, column > $2, column IN ($3, $4), ...
+        conditionRegex = regexp.MustCompile(`(?i)([a-z_][\w.]*)\s*(?:=|<>|!=|<=|>=|<|>|\s(?:NOT\s+)?(?:I?LIKE|IN)\s*\(?)\s*((?:\$\d+\s*,?\s*)+)`)
+        insertRegex    = regexp.MustCompile(`(?is)INSERT\s+INTO\s+\S+\s*\(([^)]*)\)\s*VALUES\s*(.*)`)
+        tupleRegex     = regexp.MustCompile(`\(([^()]*)\)`)
+        paramRegex     = regexp.MustCompile(`\$(\d+)`)
+)
+
+// ArgColumns returns the column of every $n placeholder of the query, as
+// the queries of Build write them, e.g. INSERT INTO t (a, b) VALUES ($1, $2)
+// or UPDATE t SET a = $1 WHERE b > $2. An unknown column is "".
+func ArgColumns(query string, n int) []string {
+        columns := make([]string, n)
+        set := func(param, column string) {
+                if i, err := strconv.Atoi(param); err == nil && i >= 1 && i <= n {
+                        column = column[strings.LastIndexByte(column, '.')+1:]
+                        columns[i-1] = strings.TrimSpace(column)
+                }
+        }
+        if m := insertRegex.FindStringSubmatch(query); m != nil {
+                names := strings.Split(m[1], ",")
+                for _, tuple := range tupleRegex.FindAllStringSubmatch(m[2], -1) {
+                        for i, value := range strings.Split(tuple[1], ",") {
+                                if p := paramRegex.FindStringSubmatch(value); p != nil && i < len(names) {
+                                        set(p[1], names[i])
+                                }
+                        }
+                }
+        }
+        for _, m := range conditionRegex.FindAllStringSubmatch(query, -1) {
+                for _, p := range paramRegex.FindAllStringSubmatch(m[2], -1) {
+                        set(p[1], m[1])
+                }
+        }
+        return columns
+}
+
+// MaskArgs returns a copy of the query args to log, the value of a column
+// redacted by suki.RedactKey, or a string caught by suki.RedactValue,
+// is replaced by suki.Redacted.
+func MaskArgs(query string, args []interface{}) []interface{} {
+        columns := ArgColumns(query, len(args))
+        masked := make([]interface{}, len(args))
+        for i, arg := range args {
+                masked[i] = arg
+                if suki.RedactKey(columns[i]) {
+                        masked[i] = suki.Redacted
+                        continue
+                }
+                if s, ok := arg.(string); ok && suki.RedactValue(s) {
+                        masked[i] = suki.Redacted
+                }
+        }
+        return masked
+}
@@ -0,0 +1,54 @@
+/*  mask_test.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 21, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 21/10/26 16:55
+ */
+
+package sqlx
+
+import (
+        "testing"
+
+        "github.com/stretchr/testify/assert"
+        "gitlab.com/suryakencana007/suki"
+)
+
+type courier struct {
+        ID       int    `json:"courier_id" sql:"courier_id"`
+        Name     string `json:"name" sql:"name"`
+        Password string `json:"password" sql:"password"`
+}
+
+func (courier) TableName() string {
+        return "couriers"
+}
+
+func TestArgColumns(t *testing.T) {
+        assert.Equal(t,
+                []string{"name", "password", "courier_id", "name", "courier_id"},
+                ArgColumns("UPDATE couriers SET name = $1, password = $2 WHERE couriers.courier_id = $3 AND name LIKE $4 OR courier_id IN ($5)", 5),
+        )
+        assert.Equal(t,
+                []string{"a", "b", "a", "b", ""},
+                ArgColumns("INSERT INTO t (a, b) VALUES ($1, $2), ($3, $4)", 5),
+        )
+}
+
+func TestMaskArgs(t *testing.T) {
+        c := &courier{ID: 7, Name: "phil", Password: "s3cr3t"}
+        query, args := Build().Updates(c).Where("name = ?", "phil").ToSQL()
+        masked := MaskArgs(query, args)
+        assert.Len(t, masked, len(args))
+        assert.Contains(t, masked, "phil")
+        assert.Contains(t, masked, suki.Redacted)
+        assert.NotContains(t, masked, "s3cr3t")
+        assert.Contains(t, args, "s3cr3t", "the args are not changed")
+
+        query, args = Build().Insert(c).ToSQL()
+        assert.NotContains(t, MaskArgs(query, args), "s3cr3t")
+
+        masked = MaskArgs("SELECT * FROM cards WHERE number = $1", []interface{}{"4111 1111 1111 1111"})
+        assert.Equal(t, []interface{}{suki.Redacted}, masked)
+}
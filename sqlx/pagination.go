@@ -121,6 +121,13 @@ func GetPagination(p *Pagination) (string, error) {
         return strings.Join(q, " "), nil
 }
 
+// Result returns the pagination block of the response envelope once the
+// page query and QueryAll have been executed and Total is set, with links
+// built from the url of the original request, e.g. r.URL.
+func (p *Pagination) Result(u *url.URL) suki.Pagination {
+        return suki.NewPagination(u, p.Page, p.Limit, p.Total)
+}
+
 func Contains(a []string, x string) bool {
         for _, n := range a {
                 if x == n {
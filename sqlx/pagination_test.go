@@ -57,6 +57,29 @@ func TestGetPagination(t *testing.T) {
         assert.Equal(expected, actual)
 }
 
+func TestPaginationResult(t *testing.T) {
+        assert := assert.New(t)
+        u, _ := url.Parse("/groups?filters[category:eq]=RG&sort=name&page[number]=2&page[size]=2")
+        pagination := &Pagination{
+                Query:        `SELECT group_id, name, parent_id, category, description, activated FROM groups g`,
+                Params:       u.Query(),
+                Model:        group{},
+                AllowFields:  []string{"group_id", "category", "name", "activated"},
+                DefaultValue: "name",
+                Aka:          "g",
+        }
+        _, err := GetPagination(pagination)
+        assert.NoError(err)
+        pagination.Total = 5
+
+        actual := pagination.Result(u)
+        assert.Equal(2, actual.Page)
+        assert.Equal(2, actual.Size)
+        assert.Equal(3, actual.TotalPages)
+        assert.Equal("/groups?filters%5Bcategory%3Aeq%5D=RG&page%5Bnumber%5D=3&page%5Bsize%5D=2&sort=name", actual.Links.Next)
+        assert.Equal("/groups?filters%5Bcategory%3Aeq%5D=RG&page%5Bnumber%5D=1&page%5Bsize%5D=2&sort=name", actual.Links.Prev)
+}
+
 // Group representation of group
 type group struct {
         ID          uint16         `json:"group_id"`
@@ -74,22 +74,22 @@ func (r *DB) PrepareContext(ctx context.Context, query string) (stmt *sql.Stmt,
 
 func (r *DB) QueryRowCtx(ctx context.Context, fn func(rs *sql.Row) error, query string, args ...interface{}) error {
         if r.DB == nil {
-                suki.Error("the database connection is nil",
+                suki.FromContext(ctx).Error("the database connection is nil",
                         suki.Field("query", query),
-                        suki.Field("args", args))
+                        suki.Field("args", MaskArgs(query, args)))
                 return fmt.Errorf("cannot access your db connection")
         }
         rs := r.DB.QueryRowContext(ctx, query, args...)
         if err := fn(rs); err != nil {
                 if err == sql.ErrNoRows {
-                        suki.Warn("result not found",
+                        suki.FromContext(ctx).Warn("result not found",
                                 suki.Field("query", query),
-                                suki.Field("args", args))
+                                suki.Field("args", MaskArgs(query, args)))
                         return nil
                 }
-                suki.Error("query row failed",
+                suki.FromContext(ctx).Error("query row failed",
                         suki.Field("query", query),
-                        suki.Field("args", args))
+                        suki.Field("args", MaskArgs(query, args)))
                 return err
         }
         return nil
@@ -97,16 +97,16 @@ func (r *DB) QueryRowCtx(ctx context.Context, fn func(rs *sql.Row) error, query
 
 func (r *DB) QueryCtx(ctx context.Context, fn func(rs *sql.Rows) error, query string, args ...interface{}) error {
         if r.DB == nil {
-                suki.Error("the database connection is nil",
+                suki.FromContext(ctx).Error("the database connection is nil",
                         suki.Field("query", query),
-                        suki.Field("args", args))
+                        suki.Field("args", MaskArgs(query, args)))
                 return fmt.Errorf("cannot access your db connection")
         }
         rs, err := r.DB.QueryContext(ctx, query, args...)
         if err != nil {
-                suki.Warn("query failed",
+                suki.FromContext(ctx).Warn("query failed",
                         suki.Field("query", query),
-                        suki.Field("args", args))
+                        suki.Field("args", MaskArgs(query, args)))
                 return err
         }
         defer func() {
@@ -115,14 +115,14 @@ func (r *DB) QueryCtx(ctx context.Context, fn func(rs *sql.Rows) error, query st
 
         if err := fn(rs); err != nil {
                 if err == sql.ErrNoRows {
-                        suki.Warn("result not found",
+                        suki.FromContext(ctx).Warn("result not found",
                                 suki.Field("query", query),
-                                suki.Field("args", args))
+                                suki.Field("args", MaskArgs(query, args)))
                         return nil
                 }
-                suki.Error("query row failed",
+                suki.FromContext(ctx).Error("query row failed",
                         suki.Field("query", query),
-                        suki.Field("args", args))
+                        suki.Field("args", MaskArgs(query, args)))
                 return err
         }
         return nil
@@ -132,37 +132,37 @@ func (r *DB) TxExecContextWithID(ctx context.Context, tx *sql.Tx, query string,
         if strings.Contains(query, "RETURNING id") {
                 stmt, err := tx.PrepareContext(ctx, query)
                 if err != nil {
-                        suki.Error("ExecContextWithID:",
+                        suki.FromContext(ctx).Error("ExecContextWithID:",
                                 suki.Field("error", err.Error()),
                                 suki.Field("query", query),
-                                suki.Field("args", args),
+                                suki.Field("args", MaskArgs(query, args)),
                         )
                         return nil, err
                 }
                 if err := stmt.QueryRowContext(ctx, args...).Scan(&ids); err != nil {
-                        suki.Error("ExecContextWithID:",
+                        suki.FromContext(ctx).Error("ExecContextWithID:",
                                 suki.Field("error", err.Error()),
                                 suki.Field("query", query),
-                                suki.Field("args", args),
+                                suki.Field("args", MaskArgs(query, args)),
                         )
                         return nil, err
                 }
                 err = stmt.Close()
                 if err != nil {
-                        suki.Error("ExecContextWithID:",
+                        suki.FromContext(ctx).Error("ExecContextWithID:",
                                 suki.Field("error", err.Error()),
                                 suki.Field("query", query),
-                                suki.Field("args", args),
+                                suki.Field("args", MaskArgs(query, args)),
                         )
                         return nil, err
                 }
                 return ids, nil
         }
         err = fmt.Errorf("query has no RETUNING id syntax")
-        suki.Error("ExecContextWithID:",
+        suki.FromContext(ctx).Error("ExecContextWithID:",
                 suki.Field("error", err.Error()),
                 suki.Field("query", query),
-                suki.Field("args", args),
+                suki.Field("args", MaskArgs(query, args)),
         )
         return nil, err
 }
@@ -170,35 +170,35 @@ func (r *DB) TxExecContextWithID(ctx context.Context, tx *sql.Tx, query string,
 func (r *DB) TxExecContext(ctx context.Context, tx *sql.Tx, query string, args ...interface{}) (affected int64, err error) {
         stmt, err := tx.PrepareContext(ctx, query)
         if err != nil {
-                suki.Error("ExecContextWithID:",
+                suki.FromContext(ctx).Error("ExecContextWithID:",
                         suki.Field("error", err.Error()),
                         suki.Field("query", query),
-                        suki.Field("args", args),
+                        suki.Field("args", MaskArgs(query, args)),
                 )
                 return affected, err
         }
         result, err := stmt.ExecContext(ctx, args...)
         if err != nil {
-                suki.Error("ExecContextWithID:",
+                suki.FromContext(ctx).Error("ExecContextWithID:",
                         suki.Field("error", err.Error()),
                         suki.Field("query", query),
-                        suki.Field("args", args),
+                        suki.Field("args", MaskArgs(query, args)),
                 )
                 return affected, err
         }
         affected, err = result.RowsAffected()
         if err != nil {
-                suki.Error("TxExecContext: RowsAffected",
+                suki.FromContext(ctx).Error("TxExecContext: RowsAffected",
                         suki.Field("error", err.Error()),
                 )
                 return affected, err
         }
         err = stmt.Close()
         if err != nil {
-                suki.Error("TxExecContext:",
+                suki.FromContext(ctx).Error("TxExecContext:",
                         suki.Field("error", err.Error()),
                         suki.Field("query", query),
-                        suki.Field("args", args),
+                        suki.Field("args", MaskArgs(query, args)),
                 )
                 return affected, err
         }
@@ -210,7 +210,7 @@ func (r *DB) TxCommit(ctx context.Context, tx *sql.Tx) error {
         // commit db transaction
         if err := tx.Commit(); err != nil {
                 if err = tx.Rollback(); err != nil {
-                        suki.Error("TxCommit:",
+                        suki.FromContext(ctx).Error("TxCommit:",
                                 suki.Field("error", err.Error()),
                         )
                         return err
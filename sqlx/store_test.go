@@ -101,7 +101,7 @@ func (s *ConnPGSuite) TestMainCommitInFailedTransaction() {
 
 func (s *ConnPGSuite) TestExecContext() {
         t := s.T()
-        ctx, cancel := s.DB.BeginCtx()
+        ctx, cancel := s.DB.BeginCtx(context.Background())
         defer cancel()
         args := []interface{}{
                 1003,
@@ -117,7 +117,7 @@ func (s *ConnPGSuite) TestExecContext() {
 
 func (s *ConnPGSuite) TestPrepareContext() {
         t := s.T()
-        ctx, cancel := s.DB.BeginCtx()
+        ctx, cancel := s.DB.BeginCtx(context.Background())
         defer cancel()
         args := []interface{}{
                 1003,
@@ -135,7 +135,7 @@ func (s *ConnPGSuite) TestPrepareContext() {
 
 func (s *ConnPGSuite) TestQueryCtxFailed() {
         t := s.T()
-        ctx, cancel := s.DB.BeginCtx()
+        ctx, cancel := s.DB.BeginCtx(context.Background())
         defer cancel()
         err := s.DB.QueryCtx(ctx, func(rows *sql.Rows) error {
                 return nil
@@ -146,7 +146,7 @@ func (s *ConnPGSuite) TestQueryCtxFailed() {
 func (s *ConnPGSuite) TestGetUserID() {
         t := s.T()
         names := make([]string, 0)
-        ctx, cancel := s.DB.BeginCtx()
+        ctx, cancel := s.DB.BeginCtx(context.Background())
         defer cancel()
         err := s.DB.QueryCtx(ctx, func(rows *sql.Rows) error {
                 for rows.Next() {
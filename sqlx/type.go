@@ -109,7 +109,7 @@ type NullTime struct {
 }
 
 // Scan implements the Scanner interface.
-func (nt NullTime) Scan(value interface{}) error {
+func (nt *NullTime) Scan(value interface{}) error {
         nt.Time, nt.Valid = value.(time.Time)
         return nil
 }
@@ -0,0 +1,59 @@
+/*  type_test.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 20, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 20/10/26 10:20
+ */
+
+package sqlx
+
+import (
+        "strings"
+        "testing"
+        "time"
+
+        "github.com/stretchr/testify/assert"
+        "github.com/stretchr/testify/require"
+        "gitlab.com/suryakencana007/suki"
+)
+
+type payment struct {
+        Name   NullString  `csv:"Nama"`
+        PaidAt NullTime    `csv:"Dibayar,format=2006-01-02"`
+        Amount NullFloat64 `csv:"Jumlah,format=2"`
+}
+
+func TestNullTimeScan(t *testing.T) {
+        var nt NullTime
+        now := time.Now()
+        assert.NoError(t, nt.Scan(now))
+        assert.True(t, nt.Valid)
+        assert.Equal(t, now, nt.Time)
+        assert.NoError(t, nt.Scan(nil))
+        assert.False(t, nt.Valid)
+}
+
+func TestNullTypesCSV(t *testing.T) {
+        rows, err := suki.MarshalCSV([]payment{
+                {Name: String("Warung Bu Tini"), PaidAt: Time(time.Date(2019, 11, 24, 10, 0, 0, 0, time.UTC)), Amount: Float64(45000)},
+                {},
+        })
+        require.NoError(t, err)
+        assert.Equal(t, [][]string{
+                {"Nama", "Dibayar", "Jumlah"},
+                {"Warung Bu Tini", "2019-11-24", "45000.00"},
+                {"", "", ""},
+        }, rows)
+
+        payments := make([]payment, 0)
+        errs, err := suki.UnmarshalCSV(strings.NewReader("Nama,Dibayar,Jumlah\nWarung Bu Tini,2019-11-24,45000.00\n,,\n"), &payments, false)
+        require.NoError(t, err)
+        assert.Nil(t, errs)
+        require.Len(t, payments, 2)
+        assert.Equal(t, String("Warung Bu Tini"), payments[0].Name)
+        assert.Equal(t, Time(time.Date(2019, 11, 24, 0, 0, 0, 0, time.UTC)), payments[0].PaidAt)
+        assert.Equal(t, Float64(45000), payments[0].Amount)
+        assert.False(t, payments[1].Name.Valid)
+        assert.False(t, payments[1].PaidAt.Valid)
+}
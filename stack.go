@@ -0,0 +1,370 @@
+/*  stack.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 21, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 24/10/26 13:10
+ */
+
+package suki
+
+import (
+        "errors"
+        "fmt"
+        "os"
+        "reflect"
+        "runtime"
+        "strconv"
+        "strings"
+        "sync"
+
+        "go.uber.org/zap"
+        "go.uber.org/zap/zapcore"
+)
+
+// StackOptions select the frames of the stack traces, a frame is dropped
+// when its function starts with one of the Skip prefixes.
+type StackOptions struct {
+        Depth int      // frames kept, 32 by default
+        Skip  []string // e.g. "net/http." or the package of a middleware
+}
+
+// DefaultStackOptions drop the runtime, the logger and the http routing
+// frames, used until SetStackOptions or Configure replaces them.
+var DefaultStackOptions = StackOptions{
+        Depth: 32,
+        Skip: []string{
+                "runtime.",
+                "testing.",
+                "reflect.",
+                "net/http.",
+                "go.uber.org/zap",
+                "github.com/go-chi/chi",
+                "gitlab.com/suryakencana007/suki/ruuto.",
+        },
+}
+
+var (
+        stackMu   sync.RWMutex
+        stackOpts = DefaultStackOptions
+)
+
+// The leading frames of the logger itself are dropped by function, the
+// frames of the other suki functions below them are kept.
+var (
+        loggerPrefixes = []string{
+                "go.uber.org/zap",
+                "gitlab.com/suryakencana007/suki.(*zapLog).",
+                "gitlab.com/suryakencana007/suki.(*errorCore).",
+                "gitlab.com/suryakencana007/suki.(*errorEntry).",
+                "gitlab.com/suryakencana007/suki.(*boxedFields).",
+                "gitlab.com/suryakencana007/suki.(*slogLogging).",
+                "gitlab.com/suryakencana007/suki.(*slogHandler).",
+                "gitlab.com/suryakencana007/suki.(*stdWriter).",
+                "gitlab.com/suryakencana007/suki.(*grpcLogger).",
+        }
+        loggerFuncs = map[string]bool{
+                "gitlab.com/suryakencana007/suki.Debug":     true,
+                "gitlab.com/suryakencana007/suki.Info":      true,
+                "gitlab.com/suryakencana007/suki.Warn":      true,
+                "gitlab.com/suryakencana007/suki.Error":     true,
+                "gitlab.com/suryakencana007/suki.Fatal":     true,
+                "gitlab.com/suryakencana007/suki.Panic":     true,
+                "gitlab.com/suryakencana007/suki.logSkip":   true,
+                "gitlab.com/suryakencana007/suki.logPC":     true,
+                "gitlab.com/suryakencana007/suki.logAt":     true,
+                "gitlab.com/suryakencana007/suki.Callers":   true,
+                "gitlab.com/suryakencana007/suki.callers":   true,
+                "gitlab.com/suryakencana007/suki.WithStack": true,
+                "gitlab.com/suryakencana007/suki.Errorf":    true,
+        }
+)
+
+// SetStackOptions replaces the options of the stack traces.
+func SetStackOptions(o StackOptions) {
+        if o.Depth <= 0 {
+                o.Depth = DefaultStackOptions.Depth
+        }
+        stackMu.Lock()
+        defer stackMu.Unlock()
+        stackOpts = o
+}
+
+func currentStackOptions() StackOptions {
+        stackMu.RLock()
+        defer stackMu.RUnlock()
+        return stackOpts
+}
+
+// Frame is a function call of a StackTrace.
+type Frame struct {
+        Function string `json:"function"`
+        File     string `json:"file"`
+        Line     int    `json:"line"`
+}
+
+// StackTrace lists the frames of a stack, the innermost first.
+type StackTrace []Frame
+
+// String formats the stack as zap does,
+//
+//	main.deliver
+//		/app/courier.go:42
+func (s StackTrace) String() string {
+        var b strings.Builder
+        for i, f := range s {
+                if i > 0 {
+                        b.WriteByte('\n')
+                }
+                b.WriteString(f.Function)
+                b.WriteString("\n\t")
+                b.WriteString(f.File)
+                b.WriteByte(':')
+                b.WriteString(strconv.Itoa(f.Line))
+        }
+        return b.String()
+}
+
+// Callers returns the stack of the caller, skip frames above it are
+// dropped, filtered by the options of SetStackOptions.
+func Callers(skip int) StackTrace {
+        return callers(skip+1, currentStackOptions())
+}
+
+func callers(skip int, o StackOptions) StackTrace {
+        if o.Depth <= 0 {
+                o.Depth = DefaultStackOptions.Depth
+        }
+        // room for the dropped frames
+        pcs := make([]uintptr, o.Depth+64)
+        n := runtime.Callers(skip+2, pcs)
+        frames := runtime.CallersFrames(pcs[:n])
+        stack := make(StackTrace, 0, o.Depth)
+        leading := true
+        for len(stack) < o.Depth {
+                f, more := frames.Next()
+                if leading && loggerFrame(f) {
+                        if !more {
+                                break
+                        }
+                        continue
+                }
+                leading = false
+                if f.Function != "" && !skipFrame(f.Function, o.Skip) {
+                        stack = append(stack, Frame{Function: f.Function, File: f.File, Line: f.Line})
+                }
+                if !more {
+                        break
+                }
+        }
+        return stack
+}
+
+func loggerFrame(f runtime.Frame) bool {
+        return loggerFuncs[f.Function] || skipFrame(f.Function, loggerPrefixes)
+}
+
+func skipFrame(function string, skip []string) bool {
+        for _, prefix := range skip {
+                if strings.HasPrefix(function, prefix) {
+                        return true
+                }
+        }
+        return false
+}
+
+// stackError is an error carrying the stack of its creation.
+type stackError struct {
+        err   error
+        stack StackTrace
+}
+
+func (e *stackError) Error() string {
+        return e.err.Error()
+}
+
+func (e *stackError) Unwrap() error {
+        return e.err
+}
+
+func (e *stackError) StackTrace() StackTrace {
+        return e.stack
+}
+
+// WithStack returns err with the stack of the caller, logged in place of
+// the stack of the log line. An error already carrying a stack is kept.
+func WithStack(err error) error {
+        if err == nil || StackOf(err) != nil {
+                return err
+        }
+        return &stackError{err: err, stack: Callers(1)}
+}
+
+// Errorf is fmt.Errorf with the stack of the caller, see WithStack.
+func Errorf(format string, args ...interface{}) error {
+        err := fmt.Errorf(format, args...)
+        if StackOf(err) != nil {
+                return err
+        }
+        return &stackError{err: err, stack: Callers(1)}
+}
+
+// StackOf returns the stack of the innermost error of the chain
+// created by WithStack or Errorf, nil when there is none.
+func StackOf(err error) StackTrace {
+        var stack StackTrace
+        walkChain(err, func(err error) {
+                if s, ok := err.(interface{ StackTrace() StackTrace }); ok {
+                        stack = s.StackTrace()
+                }
+        })
+        return stack
+}
+
+// walkChain calls fn with err and each of its causes, depth first.
+func walkChain(err error, fn func(err error)) {
+        for err != nil {
+                fn(err)
+                if multi, ok := err.(interface{ Unwrap() []error }); ok {
+                        for _, cause := range multi.Unwrap() {
+                                walkChain(cause, fn)
+                        }
+                        return
+                }
+                err = errors.Unwrap(err)
+        }
+}
+
+// errorChain marshals the causes of an error as {"type", "error"} objects,
+// the stack wrappers of WithStack are left out.
+type errorChain []error
+
+func (c errorChain) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+        for _, err := range c {
+                err := err
+                _ = enc.AppendObject(zapcore.ObjectMarshalerFunc(func(enc zapcore.ObjectEncoder) error {
+                        enc.AddString("type", reflect.TypeOf(err).String())
+                        enc.AddString("error", err.Error())
+                        return nil
+                }))
+        }
+        return nil
+}
+
+// ErrChain returns the errorChain field of err, each cause wrapped with %w
+// and its type, a nil error is skipped.
+func ErrChain(err error) ZapField {
+        return NamedErrChain("error", err)
+}
+
+// NamedErrChain returns the field of the chain of err under key+"Chain".
+func NamedErrChain(key string, err error) ZapField {
+        if err == nil {
+                return zap.Skip()
+        }
+        chain := make(errorChain, 0)
+        walkChain(err, func(err error) {
+                if _, ok := err.(*stackError); !ok {
+                        chain = append(chain, err)
+                }
+        })
+        return zap.Array(key+"Chain", chain)
+}
+
+// ErrorCore adds the errorChain of the error fields to the Error entries
+// and above, with the stack of the error or of the log line. o is nil to
+// follow SetStackOptions. The core may be a Tee, the stack is captured once
+// for all of its cores.
+func ErrorCore(core Core, o *StackOptions) Core {
+        return &errorCore{Core: core, opts: o}
+}
+
+type errorCore struct {
+        zapcore.Core
+        opts   *StackOptions
+        fields []ZapField // error fields added by With
+}
+
+func (c *errorCore) With(fields []ZapField) Core {
+        errs := append([]ZapField(nil), c.fields...)
+        for _, f := range fields {
+                if f.Type == zapcore.ErrorType {
+                        errs = append(errs, f)
+                }
+        }
+        return &errorCore{Core: c.Core.With(fields), opts: c.opts, fields: errs}
+}
+
+// Check leaves the entries below Error to core, the cores checked for an
+// Error entry are written by an errorEntry.
+func (c *errorCore) Check(e zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+        if e.Level < zapcore.ErrorLevel {
+                return c.Core.Check(e, ce)
+        }
+        if checked := c.Core.Check(e, nil); checked != nil {
+                w := &errorEntry{errorCore: c, checked: checked}
+                // zap sets the ErrorOutput of the logger after Check returns
+                w.outer = ce.AddCore(e, w)
+                return w.outer
+        }
+        return ce
+}
+
+func (c *errorCore) Write(e zapcore.Entry, fields []ZapField) error {
+        if e.Level < zapcore.ErrorLevel {
+                return c.Core.Write(e, fields)
+        }
+        e, fields = c.annotate(e, fields)
+        return c.Core.Write(e, fields)
+}
+
+// annotate adds the errorChain fields and the stack to an Error entry.
+func (c *errorCore) annotate(e zapcore.Entry, fields []ZapField) (zapcore.Entry, []ZapField) {
+        var stack StackTrace
+        out := fields
+        for _, f := range append(append([]ZapField(nil), c.fields...), fields...) {
+                err, ok := f.Interface.(error)
+                if f.Type != zapcore.ErrorType || !ok {
+                        continue
+                }
+                if len(out) == len(fields) {
+                        // copy on the first change, the fields belong to the caller
+                        out = append(make([]ZapField, 0, len(fields)+2), fields...)
+                }
+                out = append(out, NamedErrChain(f.Key, err))
+                if s := StackOf(err); stack == nil && s != nil {
+                        stack = s
+                }
+        }
+        if e.Stack == "" {
+                if stack == nil {
+                        o := currentStackOptions()
+                        if c.opts != nil {
+                                o = *c.opts
+                        }
+                        stack = callers(1, o)
+                }
+                e.Stack = stack.String()
+        }
+        return e, out
+}
+
+// errorEntry writes an Error entry to the cores checked by the core of an
+// errorCore, annotated once for all of them.
+type errorEntry struct {
+        *errorCore
+        checked *zapcore.CheckedEntry
+        outer   *zapcore.CheckedEntry // the entry writing errorEntry
+}
+
+func (w *errorEntry) Write(e zapcore.Entry, fields []ZapField) error {
+        e, fields = w.annotate(e, fields)
+        w.checked.Entry = e
+        // the write errors of the cores go to the ErrorOutput of the logger
+        w.checked.ErrorOutput = w.outer.ErrorOutput
+        if w.checked.ErrorOutput == nil {
+                w.checked.ErrorOutput = zapcore.Lock(os.Stderr)
+        }
+        w.checked.Write(fields...)
+        return nil
+}
@@ -0,0 +1,142 @@
+/*  stack_test.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 21, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 24/10/26 13:10
+ */
+
+package suki
+
+import (
+        "bytes"
+        "encoding/json"
+        "errors"
+        "fmt"
+        "io"
+        "strings"
+        "testing"
+
+        "github.com/stretchr/testify/assert"
+        "github.com/stretchr/testify/require"
+        "go.uber.org/zap"
+        "go.uber.org/zap/zapcore"
+)
+
+func geocode(order string) error {
+        return Errorf("geocode %s: %w", order, io.ErrUnexpectedEOF)
+}
+
+func logLine(t *testing.T, log func(l Logging)) map[string]interface{} {
+        var buf bytes.Buffer
+        log(newZapLog(Options{Outputs: []zapcore.WriteSyncer{zapcore.AddSync(&buf)}, DisableCaller: true}))
+        line := make(map[string]interface{})
+        require.NoError(t, json.Unmarshal(buf.Bytes(), &line))
+        return line
+}
+
+func TestErrorChain(t *testing.T) {
+        err := fmt.Errorf("deliver: %w", ErrUnavailable.Wrap(geocode("INV-7")))
+        line := logLine(t, func(l Logging) {
+                l.Error("deliver work order failed", Err(err))
+        })
+
+        chain, ok := line["errorChain"].([]interface{})
+        require.True(t, ok, "%v", line)
+        types := make([]string, 0)
+        for _, link := range chain {
+                types = append(types, link.(map[string]interface{})["type"].(string))
+        }
+        assert.Equal(t, []string{"*fmt.wrapError", "*suki.AppError", "*fmt.wrapError", "*errors.errorString"}, types)
+        assert.Equal(t, "unexpected EOF", chain[3].(map[string]interface{})["error"])
+
+        stack := line["stacktrace"].(string)
+        assert.True(t, strings.HasPrefix(stack, "gitlab.com/suryakencana007/suki.geocode\n"), "the stack of the error creation: %s", stack)
+        assert.NotContains(t, stack, "go.uber.org/zap")
+        assert.NotContains(t, stack, "runtime.")
+        assert.NotContains(t, stack, "testing.tRunner")
+}
+
+func TestErrorStackOfLogLine(t *testing.T) {
+        line := logLine(t, func(l Logging) {
+                l.With(Err(io.EOF)).Error("read courier position failed")
+        })
+        assert.NotNil(t, line["errorChain"], "the error of With")
+        assert.True(t, strings.HasPrefix(line["stacktrace"].(string), "gitlab.com/suryakencana007/suki.TestErrorStackOfLogLine"))
+
+        line = logLine(t, func(l Logging) {
+                l.Warn("slow courier", Err(io.EOF))
+        })
+        assert.Nil(t, line["stacktrace"])
+        assert.Nil(t, line["errorChain"])
+}
+
+func TestErrorStackOfTee(t *testing.T) {
+        old := Current()
+        defer SetLogger(old)
+        var first, second bytes.Buffer
+        SetLogger(newZapLog(Options{
+                Outputs:       []zapcore.WriteSyncer{zapcore.AddSync(&first)},
+                Tee:           []zapcore.WriteSyncer{zapcore.AddSync(&second)},
+                DisableCaller: true,
+        }))
+        Error("read courier position failed", Err(io.EOF))
+        Info("received work order")
+
+        assert.Equal(t, first.String(), second.String())
+        line := make(map[string]interface{})
+        require.NoError(t, json.Unmarshal(bytes.SplitN(first.Bytes(), []byte("\n"), 2)[0], &line))
+        assert.NotNil(t, line["errorChain"])
+        assert.True(t, strings.HasPrefix(line["stacktrace"].(string), "gitlab.com/suryakencana007/suki.TestErrorStackOfTee\n"), "%v", line["stacktrace"])
+        assert.Equal(t, 2, strings.Count(first.String(), "\n"), "the Info entry is written once")
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+        return 0, errors.New("disk full")
+}
+
+func TestErrorCoreErrorOutput(t *testing.T) {
+        var errOut bytes.Buffer
+        core := zapcore.NewCore(zapcore.NewJSONEncoder(NewZapProductionEncoderConfig()), zapcore.AddSync(failingWriter{}), zapcore.DebugLevel)
+        l := zap.New(ErrorCore(core, nil), zap.ErrorOutput(zapcore.AddSync(&errOut)))
+        l.Error("read courier position failed", Err(io.EOF))
+        assert.Contains(t, errOut.String(), "write error: disk full", "the ErrorOutput of the logger")
+}
+
+func TestErrorStackOfSukiFunction(t *testing.T) {
+        old := Current()
+        defer SetLogger(old)
+        var buf bytes.Buffer
+        SetLogger(newZapLog(Options{Outputs: []zapcore.WriteSyncer{zapcore.AddSync(&buf)}, DisableCaller: true}))
+        assert.Equal(t, io.EOF, NewBreaker("geocode", 1000, 10).Execute(func() error { return io.EOF }))
+
+        line := make(map[string]interface{})
+        require.NoError(t, json.Unmarshal(buf.Bytes(), &line))
+        stack := line["stacktrace"].(string)
+        assert.True(t, strings.HasPrefix(stack, "gitlab.com/suryakencana007/suki.(*CircuitBreaker).Execute\n"), "the suki function logging is kept: %s", stack)
+}
+
+func TestStackOptions(t *testing.T) {
+        defer SetStackOptions(DefaultStackOptions)
+
+        SetStackOptions(StackOptions{Depth: 1, Skip: DefaultStackOptions.Skip})
+        stack := geocode("INV-7").(interface{ StackTrace() StackTrace }).StackTrace()
+        require.Len(t, stack, 1)
+        assert.Equal(t, "gitlab.com/suryakencana007/suki.geocode", stack[0].Function)
+
+        SetStackOptions(StackOptions{Skip: append([]string{"gitlab.com/suryakencana007/suki.geocode"}, DefaultStackOptions.Skip...)})
+        stack = StackOf(geocode("INV-7"))
+        require.NotEmpty(t, stack)
+        assert.Equal(t, "gitlab.com/suryakencana007/suki.TestStackOptions", stack[0].Function)
+}
+
+func TestWithStack(t *testing.T) {
+        assert.Nil(t, WithStack(nil))
+        err := geocode("INV-7")
+        assert.Equal(t, err, WithStack(err), "the first stack is kept")
+        assert.Equal(t, StackOf(err), StackOf(fmt.Errorf("deliver: %w", err)))
+        assert.True(t, errors.Is(WithStack(io.EOF), io.EOF))
+        assert.Nil(t, StackOf(io.EOF))
+}
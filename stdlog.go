@@ -0,0 +1,79 @@
+/*  stdlog.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 21, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 23/10/26 09:10
+ */
+
+package suki
+
+import (
+        "bytes"
+        "log"
+
+        "go.uber.org/zap/zapcore"
+)
+
+// logAt logs the message at the level with l.
+func logAt(l Logging, lvl zapcore.Level, msg string, fields ...interface{}) {
+        switch {
+        case lvl <= zapcore.DebugLevel:
+                l.Debug(msg, fields...)
+        case lvl == zapcore.InfoLevel:
+                l.Info(msg, fields...)
+        case lvl == zapcore.WarnLevel:
+                l.Warn(msg, fields...)
+        case lvl == zapcore.ErrorLevel:
+                l.Error(msg, fields...)
+        case lvl == zapcore.FatalLevel:
+                l.Fatal(msg, fields...)
+        default:
+                l.Panic(msg, fields...)
+        }
+}
+
+// stdLogSkip counts the frames of stdWriter.Write and log.(*Logger).output
+// above the log function, e.g. log.Println or (*log.Logger).Printf, the
+// caller is the line calling it.
+const stdLogSkip = 3
+
+// stdWriter logs every line written by a standard logger.
+type stdWriter struct {
+        logger Logging
+        level  zapcore.Level
+}
+
+func (w *stdWriter) Write(p []byte) (int, error) {
+        for _, line := range bytes.Split(bytes.TrimRight(p, "\n"), []byte("\n")) {
+                logSkip(w.logger, w.level, stdLogSkip, string(line), nil)
+        }
+        return len(p), nil
+}
+
+// NewStdLog returns a standard logger writing its lines to l at the level,
+// l is nil to use the process wide logger.
+func NewStdLog(l Logging, lvl zapcore.Level) *log.Logger {
+        if l == nil {
+                l = Current()
+        }
+        return log.New(&stdWriter{logger: l, level: lvl}, "", 0)
+}
+
+// RedirectStdLog sends the output of the standard log package to l at the
+// level, l is nil to use the process wide logger. The returned function
+// restores the previous output, prefix and flags.
+func RedirectStdLog(l Logging, lvl zapcore.Level) func() {
+        if l == nil {
+                l = Current()
+        }
+        flags, prefix, out := log.Flags(), log.Prefix(), log.Writer()
+        log.SetFlags(0)
+        log.SetPrefix("")
+        log.SetOutput(&stdWriter{logger: l, level: lvl})
+        return func() {
+                log.SetFlags(flags)
+                log.SetPrefix(prefix)
+                log.SetOutput(out)
+        }
+}
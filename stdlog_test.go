@@ -0,0 +1,78 @@
+/*  stdlog_test.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 21, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 23/10/26 09:40
+ */
+
+package suki
+
+import (
+        "fmt"
+        "log"
+        "runtime"
+        "testing"
+
+        "github.com/stretchr/testify/assert"
+        "go.uber.org/zap"
+        "go.uber.org/zap/zapcore"
+        "go.uber.org/zap/zaptest/observer"
+)
+
+func observedZap() (*zapLog, *observer.ObservedLogs) {
+        core, logs := observer.New(zapcore.DebugLevel)
+        return &zapLog{logger: zap.New(core), noCaller: true}, logs
+}
+
+// callerZap is observedZap with the caller fields.
+func callerZap() (*zapLog, *observer.ObservedLogs) {
+        core, logs := observer.New(zapcore.DebugLevel)
+        return &zapLog{logger: zap.New(core)}, logs
+}
+
+// assertCaller asserts the caller of the entries are the lines following
+// line, in the test function.
+func assertCaller(t *testing.T, logs *observer.ObservedLogs, file string, line int, function string) {
+        entries := logs.AllUntimed()
+        assert.NotEmpty(t, entries)
+        for i, e := range entries {
+                assert.Equal(t, fmt.Sprintf("%s:%d", file, line+1+i), e.ContextMap()["caller"], e.Message)
+                assert.Equal(t, function, e.ContextMap()["function"], e.Message)
+        }
+}
+
+func TestRedirectStdLog(t *testing.T) {
+        l, logs := observedZap()
+        restore := RedirectStdLog(l, zapcore.WarnLevel)
+        log.Println("error writing record to csv:", "short write")
+        log.Print("first\nsecond")
+        restore()
+
+        entries := logs.AllUntimed()
+        assert.Len(t, entries, 3)
+        assert.Equal(t, "error writing record to csv: short write", entries[0].Message)
+        assert.Equal(t, zapcore.WarnLevel, entries[0].Level)
+        assert.Equal(t, "second", entries[2].Message)
+        assert.NotEqual(t, 0, log.Flags(), "the flags are restored")
+}
+
+func TestNewStdLog(t *testing.T) {
+        l, logs := observedZap()
+        NewStdLog(l, zapcore.ErrorLevel).Printf("http: TLS handshake error from %s", "10.0.0.7:5000")
+        assert.Equal(t, 1, logs.FilterMessage("http: TLS handshake error from 10.0.0.7:5000").Len())
+        assert.Equal(t, zapcore.ErrorLevel, logs.All()[0].Level)
+}
+
+func TestStdLogCaller(t *testing.T) {
+        l, logs := callerZap()
+        restore := RedirectStdLog(l, zapcore.InfoLevel)
+        defer restore()
+        std := NewStdLog(l, zapcore.WarnLevel)
+
+        _, _, line, _ := runtime.Caller(0)
+        log.Println("error writing record to csv:", "short write")
+        std.Printf("http: TLS handshake error from %s", "10.0.0.7:5000")
+        log.Print("first")
+        assertCaller(t, logs, "stdlog_test.go", line, "TestStdLogCaller")
+}
@@ -0,0 +1,251 @@
+/*  stream.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 20, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 20/10/26 11:40
+ */
+
+package suki
+
+import (
+        "bufio"
+        "database/sql"
+        "encoding/json"
+        "fmt"
+        "io"
+        "net/http"
+        "reflect"
+)
+
+const ContentTypeNDJSON = "application/x-ndjson"
+
+// streamResponse sends the headers and status with the first bytes of the body,
+// until then an error can still be answered with the error envelope.
+type streamResponse struct {
+        w       http.ResponseWriter
+        r       *http.Request
+        header  func(w http.ResponseWriter)
+        prefix  []byte
+        started bool
+}
+
+func (s *streamResponse) start() error {
+        if s.started {
+                return nil
+        }
+        s.started = true
+        s.header(s.w)
+        if status, ok := ResponseStatus(s.w, s.r); ok {
+                s.w.WriteHeader(status)
+        }
+        if len(s.prefix) > 0 {
+                _, err := s.w.Write(s.prefix)
+                return err
+        }
+        return nil
+}
+
+func (s *streamResponse) Write(p []byte) (int, error) {
+        if err := s.start(); err != nil {
+                return 0, err
+        }
+        return s.w.Write(p)
+}
+
+// JSONRows returns the next item to encode, or io.EOF after the last one.
+type JSONRows func() (interface{}, error)
+
+// JSONFromSlice iterates over the elements of a slice already in memory.
+func JSONFromSlice(v interface{}) JSONRows {
+        val := reflect.ValueOf(v)
+        if val.Kind() != reflect.Slice && val.Kind() != reflect.Array {
+                return func() (interface{}, error) {
+                        return nil, fmt.Errorf("json: cannot stream %T, want a slice", v)
+                }
+        }
+        i := 0
+        return func() (interface{}, error) {
+                if i >= val.Len() {
+                        return nil, io.EOF
+                }
+                i++
+                return val.Index(i - 1).Interface(), nil
+        }
+}
+
+// JSONFromSQLRows iterates over a query result, e.g. inside the callback of
+// sqlx.DB.QueryCtx, with scan turning the current row into an item.
+// A nil scan returns every row as an object keyed by column name.
+func JSONFromSQLRows(rs *sql.Rows, scan func(rs *sql.Rows) (interface{}, error)) JSONRows {
+        if scan == nil {
+                scan = scanJSONObject
+        }
+        return func() (interface{}, error) {
+                if !rs.Next() {
+                        if err := rs.Err(); err != nil {
+                                return nil, err
+                        }
+                        return nil, io.EOF
+                }
+                return scan(rs)
+        }
+}
+
+func scanJSONObject(rs *sql.Rows) (interface{}, error) {
+        columns, err := rs.Columns()
+        if err != nil {
+                return nil, err
+        }
+        values := make([]interface{}, len(columns))
+        dest := make([]interface{}, len(columns))
+        for i := range values {
+                dest[i] = &values[i]
+        }
+        if err := rs.Scan(dest...); err != nil {
+                return nil, err
+        }
+        row := make(map[string]interface{}, len(columns))
+        for i, name := range columns {
+                if b, ok := values[i].([]byte); ok {
+                        row[name] = string(b)
+                        continue
+                }
+                row[name] = values[i]
+        }
+        return row, nil
+}
+
+type StreamOptions struct {
+        FlushEvery int // items between flushes to the client, 100 by default
+}
+
+// jsonStream encodes the items one by one through a buffer,
+// flushing it to the client every opts.FlushEvery items.
+type jsonStream struct {
+        out     *streamResponse
+        buf     *bufio.Writer
+        enc     *json.Encoder
+        flusher http.Flusher
+        every   int
+}
+
+func newJSONStream(w http.ResponseWriter, r *http.Request, contentType string, opts StreamOptions) *jsonStream {
+        if opts.FlushEvery <= 0 {
+                opts.FlushEvery = 100
+        }
+        out := &streamResponse{w: w, r: r, header: func(w http.ResponseWriter) {
+                w.Header().Set("Content-Type", contentType)
+        }}
+        buf := bufio.NewWriter(out)
+        enc := json.NewEncoder(buf)
+        enc.SetEscapeHTML(true)
+        flusher, _ := w.(http.Flusher)
+        return &jsonStream{out: out, buf: buf, enc: enc, flusher: flusher, every: opts.FlushEvery}
+}
+
+// fail answers with the error envelope when nothing was sent yet,
+// after that the body is truncated and only the error is returned.
+func (s *jsonStream) fail(err error) error {
+        if !s.out.started {
+                WriteError(s.out.w, s.out.r, err)
+        }
+        return err
+}
+
+func (s *jsonStream) flush() error {
+        if err := s.buf.Flush(); err != nil {
+                return err
+        }
+        if err := s.out.start(); err != nil {
+                return err
+        }
+        if s.flusher != nil {
+                s.flusher.Flush()
+        }
+        return nil
+}
+
+// each encodes every item with write, stopping when the client disconnects.
+func (s *jsonStream) each(next JSONRows, write func(n int, item interface{}) error) error {
+        for n := 1; ; n++ {
+                if err := s.out.r.Context().Err(); err != nil {
+                        return err // client is gone, nothing to answer
+                }
+                item, err := next()
+                if err == io.EOF {
+                        return nil
+                }
+                if err != nil {
+                        return s.fail(err)
+                }
+                if err := write(n, item); err != nil {
+                        return s.fail(err)
+                }
+                if n%s.every == 0 {
+                        if err := s.flush(); err != nil {
+                                return err
+                        }
+                }
+        }
+}
+
+// StreamNDJSON writes every item as a line of newline delimited JSON
+// while they are read, instead of encoding the whole list in memory.
+func StreamNDJSON(w http.ResponseWriter, r *http.Request, next JSONRows, opts StreamOptions) error {
+        s := newJSONStream(w, r, ContentTypeNDJSON, opts)
+        if err := s.each(next, func(_ int, item interface{}) error {
+                return s.enc.Encode(item)
+        }); err != nil {
+                return err
+        }
+        return s.flush()
+}
+
+// StreamJSON writes the Response() envelope with the items streamed as the
+// array in data, the meta and pagination of res are written as they are.
+func StreamJSON(w http.ResponseWriter, r *http.Request, res *response, next JSONRows, opts StreamOptions) error {
+        s := newJSONStream(w, r, "application/json; charset=utf-8", opts)
+        if _, err := s.buf.WriteString(`{`); err != nil {
+                return s.fail(err)
+        }
+        if res.Meta != nil {
+                if err := s.member("meta", res.Meta); err != nil {
+                        return s.fail(err)
+                }
+                _ = s.buf.WriteByte(',')
+        }
+        if _, err := s.buf.WriteString(`"data":[`); err != nil {
+                return s.fail(err)
+        }
+        if err := s.each(next, func(n int, item interface{}) error {
+                if n > 1 {
+                        if err := s.buf.WriteByte(','); err != nil {
+                                return err
+                        }
+                }
+                return s.enc.Encode(item)
+        }); err != nil {
+                return err
+        }
+        _ = s.buf.WriteByte(']')
+        if res.Pagination != nil {
+                _ = s.buf.WriteByte(',')
+                if err := s.member("pagination", res.Pagination); err != nil {
+                        return s.fail(err)
+                }
+        }
+        if _, err := s.buf.WriteString("}\n"); err != nil {
+                return s.fail(err)
+        }
+        return s.flush()
+}
+
+func (s *jsonStream) member(name string, v interface{}) error {
+        b, err := json.Marshal(v)
+        if err != nil {
+                return err
+        }
+        _, err = fmt.Fprintf(s.buf, "%q:%s", name, b)
+        return err
+}
@@ -0,0 +1,90 @@
+/*  stream_test.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 20, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 20/10/26 12:10
+ */
+
+package suki
+
+import (
+        "encoding/json"
+        "errors"
+        "net/http"
+        "net/http/httptest"
+        "testing"
+
+        "github.com/stretchr/testify/assert"
+)
+
+type vehicle struct {
+        Plate string `json:"plate"`
+        Area  string `json:"area"`
+}
+
+var vehicles = []vehicle{
+        {Plate: "B 1234 XY", Area: "Jakarta Selatan"},
+        {Plate: "B 5678 ZZ", Area: "Jakarta <Barat>"},
+}
+
+func TestStreamNDJSON(t *testing.T) {
+        r := httptest.NewRequest(http.MethodGet, "/vehicles", nil)
+        w := httptest.NewRecorder()
+        err := StreamNDJSON(w, r, JSONFromSlice(vehicles), StreamOptions{FlushEvery: 1})
+
+        assert.NoError(t, err)
+        assert.True(t, w.Flushed)
+        assert.Equal(t, ContentTypeNDJSON, w.Header().Get("Content-Type"))
+        assert.Equal(t,
+                "{\"plate\":\"B 1234 XY\",\"area\":\"Jakarta Selatan\"}\n"+
+                        "{\"plate\":\"B 5678 ZZ\",\"area\":\"Jakarta \\u003cBarat\\u003e\"}\n",
+                w.Body.String(),
+        )
+}
+
+func TestStreamJSON(t *testing.T) {
+        res := Response()
+        res.Success(StatusCode(StatusSuccess))
+        res.Page(Pagination{Page: 1, Size: 2, Total: 2})
+
+        r := httptest.NewRequest(http.MethodGet, "/vehicles", nil)
+        Status(r, StatusSuccess)
+        w := httptest.NewRecorder()
+        err := StreamJSON(w, r, res, JSONFromSlice(vehicles), StreamOptions{})
+        assert.NoError(t, err)
+        assert.Equal(t, StatusSuccess, w.Code)
+        assert.Equal(t, "application/json; charset=utf-8", w.Header().Get("Content-Type"))
+
+        res.Body(vehicles)
+        want, _ := json.Marshal(res)
+        assert.JSONEq(t, string(want), w.Body.String())
+
+        w = httptest.NewRecorder()
+        err = StreamJSON(w, r, Response(), JSONFromSlice([]vehicle{}), StreamOptions{})
+        assert.NoError(t, err)
+        assert.JSONEq(t, `{"meta":{},"data":[],"pagination":{}}`, w.Body.String())
+}
+
+func TestStreamJSONError(t *testing.T) {
+        r := httptest.NewRequest(http.MethodGet, "/vehicles", nil)
+        w := httptest.NewRecorder()
+        n := 0
+        err := StreamJSON(w, r, Response(), func() (interface{}, error) {
+                n++
+                if n > 1 {
+                        return nil, errors.New("connection reset")
+                }
+                return vehicles[0], nil
+        }, StreamOptions{})
+
+        assert.Error(t, err)
+        assert.Equal(t, StatusInternalError, w.Code)
+        assert.Contains(t, w.Body.String(), `"error_type":"INTERNAL_ERROR"`)
+        assert.NotContains(t, w.Body.String(), "B 1234 XY")
+
+        w = httptest.NewRecorder()
+        err = StreamNDJSON(w, r, JSONFromSlice("vehicles"), StreamOptions{})
+        assert.Error(t, err)
+        assert.Equal(t, StatusInternalError, w.Code)
+}
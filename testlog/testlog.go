@@ -0,0 +1,154 @@
+/*  testlog.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 21, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 21/10/26 20:10
+ */
+
+// Package testlog captures the entries of the suki logger in tests, e.g.
+//
+//	logs := testlog.New(t)
+//	handler.ServeHTTP(w, r)
+//	logs.AssertLogged(zapcore.ErrorLevel, "query failed", "query", q)
+//	logs.AssertNoErrors()
+package testlog
+
+import (
+        "fmt"
+        "strings"
+        "testing"
+        "time"
+
+        "github.com/stretchr/testify/assert"
+        "gitlab.com/suryakencana007/suki"
+        "go.uber.org/zap/zapcore"
+        "go.uber.org/zap/zaptest/observer"
+)
+
+// Entry is a captured entry with its decoded fields,
+// the caller and function fields of suki included.
+type Entry struct {
+        Level   zapcore.Level
+        Time    time.Time
+        Message string
+        Fields  map[string]interface{}
+}
+
+func (e Entry) String() string {
+        return fmt.Sprintf("%s %q %v", e.Level.CapitalString(), e.Message, e.Fields)
+}
+
+// Logs holds the entries logged since New.
+type Logs struct {
+        t    testing.TB
+        logs *observer.ObservedLogs
+}
+
+// New replaces the process wide logger by one capturing every level in
+// memory, redacted as the suki cores do, until the end of the test.
+func New(t testing.TB) *Logs {
+        t.Helper()
+        core, logs := observer.New(zapcore.DebugLevel)
+        previous := suki.Current()
+        suki.SetLogger(suki.NewZap(suki.RedactCore(core, nil)))
+        t.Cleanup(func() {
+                suki.SetLogger(previous)
+        })
+        return &Logs{t: t, logs: logs}
+}
+
+// Entries returns the entries captured so far.
+func (l *Logs) Entries() []Entry {
+        logged := l.logs.All()
+        entries := make([]Entry, 0, len(logged))
+        for _, e := range logged {
+                entries = append(entries, Entry{
+                        Level:   e.Level,
+                        Time:    e.Time,
+                        Message: e.Message,
+                        Fields:  e.ContextMap(),
+                })
+        }
+        return entries
+}
+
+// Messages returns the message of the entries captured so far.
+func (l *Logs) Messages() []string {
+        entries := l.Entries()
+        msgs := make([]string, 0, len(entries))
+        for _, e := range entries {
+                msgs = append(msgs, e.Message)
+        }
+        return msgs
+}
+
+// Reset forgets the entries captured so far.
+func (l *Logs) Reset() {
+        l.logs.TakeAll()
+}
+
+// Find returns the entries of the level and message having the fields,
+// given as key and value pairs.
+func (l *Logs) Find(level zapcore.Level, msg string, fields ...interface{}) []Entry {
+        found := make([]Entry, 0)
+        for _, e := range l.Entries() {
+                if e.Level == level && e.Message == msg && e.has(fields) {
+                        found = append(found, e)
+                }
+        }
+        return found
+}
+
+func (e Entry) has(fields []interface{}) bool {
+        for i := 0; i+1 < len(fields); i += 2 {
+                key, ok := fields[i].(string)
+                if !ok {
+                        return false
+                }
+                v, ok := e.Fields[key]
+                if !ok || !assert.ObjectsAreEqualValues(fields[i+1], v) {
+                        return false
+                }
+        }
+        return true
+}
+
+// AssertLogged checks that an entry of the level and message, with the
+// fields given as key and value pairs, was logged.
+func (l *Logs) AssertLogged(level zapcore.Level, msg string, fields ...interface{}) bool {
+        l.t.Helper()
+        if len(fields)%2 != 0 {
+                l.t.Errorf("testlog: fields must be key and value pairs, got %d values", len(fields))
+                return false
+        }
+        if len(l.Find(level, msg, fields...)) > 0 {
+                return true
+        }
+        l.t.Errorf("testlog: no %s entry %q with fields %v, logged:\n%s", level.CapitalString(), msg, fields, l.dump())
+        return false
+}
+
+// AssertNoErrors checks that no entry of the Error level or above was logged.
+func (l *Logs) AssertNoErrors() bool {
+        l.t.Helper()
+        errs := 0
+        for _, e := range l.logs.All() {
+                if e.Level >= zapcore.ErrorLevel {
+                        errs++
+                }
+        }
+        if errs == 0 {
+                return true
+        }
+        l.t.Errorf("testlog: %d error entries logged:\n%s", errs, l.dump())
+        return false
+}
+
+func (l *Logs) dump() string {
+        lines := make([]string, 0)
+        for _, e := range l.Entries() {
+                lines = append(lines, "\t"+e.String())
+        }
+        return strings.Join(lines, "\n")
+}
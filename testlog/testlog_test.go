@@ -0,0 +1,62 @@
+/*  testlog_test.go
+*
+* @Author:             Nanang Suryadi
+* @Date:               October 21, 2026
+* @Last Modified by:   @suryakencana007
+* @Last Modified time: 21/10/26 20:25
+ */
+
+package testlog
+
+import (
+        "context"
+        "errors"
+        "testing"
+
+        "github.com/stretchr/testify/assert"
+        "gitlab.com/suryakencana007/suki"
+        "go.uber.org/zap/zapcore"
+)
+
+// spy records the failures of the assertions instead of failing the test.
+type spy struct {
+        testing.TB
+        errors []string
+}
+
+func (s *spy) Errorf(format string, args ...interface{}) {
+        s.errors = append(s.errors, format)
+}
+
+func TestCapture(t *testing.T) {
+        previous := suki.Current()
+        t.Run("captured", func(t *testing.T) {
+                logs := New(t)
+                assert.NotEqual(t, previous, suki.Current())
+
+                suki.Info("received work order", suki.Int("items", 3))
+                suki.FromContext(context.Background()).Error("work failed", suki.Err(errors.New("late")), suki.String("password", "s3cr3t"))
+
+                assert.Equal(t, []string{"received work order", "work failed"}, logs.Messages())
+                entries := logs.Entries()
+                assert.Equal(t, zapcore.InfoLevel, entries[0].Level)
+                assert.Equal(t, int64(3), entries[0].Fields["items"])
+                assert.Equal(t, suki.Redacted, entries[1].Fields["password"])
+
+                assert.True(t, logs.AssertLogged(zapcore.ErrorLevel, "work failed", "error", "late"))
+                assert.True(t, logs.AssertLogged(zapcore.InfoLevel, "received work order", "items", 3))
+
+                s := &spy{TB: t}
+                logs.t = s
+                assert.False(t, logs.AssertLogged(zapcore.WarnLevel, "work failed"))
+                assert.False(t, logs.AssertLogged(zapcore.InfoLevel, "received work order", "items", 4))
+                assert.False(t, logs.AssertLogged(zapcore.InfoLevel, "received work order", "items"))
+                assert.False(t, logs.AssertNoErrors())
+                assert.Len(t, s.errors, 4)
+
+                logs.Reset()
+                assert.Empty(t, logs.Entries())
+                assert.True(t, logs.AssertNoErrors())
+        })
+        assert.Equal(t, previous, suki.Current(), "the logger is restored")
+}
@@ -9,33 +9,52 @@
 package suki
 
 import (
-        "fmt"
+        "context"
         "reflect"
         "strings"
+        "sync"
         "time"
 
         "github.com/go-playground/validator/v10"
 )
 
-func Validate(s interface{}) (errors []Meta) {
-        validate := validator.New()
-        _ = validate.RegisterValidation("date", DateValidation)
-        _ = validate.RegisterValidation("datetime", DatetimeValidation)
-        _ = validate.RegisterValidation("daterange", DateRangeValidation)
-        validate.RegisterTagNameFunc(func(fld reflect.StructField) string {
-                name := strings.SplitN(fld.Tag.Get("json"), ",", 2)[0]
-                if name == "-" {
-                        return ""
-                }
-                return name
+var (
+        validatorOnce sync.Once
+        validate      *validator.Validate
+)
+
+// Validator returns the validator shared by Validate and Bind, custom
+// validations should be registered on it before serving requests.
+func Validator() *validator.Validate {
+        validatorOnce.Do(func() {
+                validate = validator.New()
+                _ = validate.RegisterValidation("date", DateValidation)
+                _ = validate.RegisterValidation("datetime", DatetimeValidation)
+                _ = validate.RegisterValidation("daterange", DateRangeValidation)
+                validate.RegisterTagNameFunc(func(fld reflect.StructField) string {
+                        name := strings.SplitN(fld.Tag.Get("json"), ",", 2)[0]
+                        if name == "-" {
+                                return ""
+                        }
+                        return name
+                })
         })
+        return validate
+}
+
+// Validate validates the struct, the messages are in the default locale.
+func Validate(s interface{}) (errors []Meta) {
+        return ValidateCtx(context.Background(), s)
+}
 
-        if err := validate.Struct(s); err != nil {
+// ValidateCtx validates the struct with the messages in the locale of ctx.
+func ValidateCtx(ctx context.Context, s interface{}) (errors []Meta) {
+        if err := Validator().Struct(s); err != nil {
                 for _, err := range err.(validator.ValidationErrors) {
                         errors = append(errors, Meta{
                                 Code:    err.Field(),
                                 Type:    err.Type().String(),
-                                Message: fmt.Sprintf("Invalid Type %v for input %s", err.Value(), err.Field()),
+                                Message: T(Locale(ctx), MsgInvalidInput, err.Value(), err.Field()),
                         })
                 }
                 return errors
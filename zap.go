@@ -12,9 +12,11 @@ import (
         "fmt"
         "log"
         "os"
+        "runtime"
+        "strconv"
+        "strings"
         "time"
 
-        "github.com/go-stack/stack"
         "go.uber.org/zap"
         "go.uber.org/zap/zapcore"
 )
@@ -22,31 +24,130 @@ import (
 type ZapField = zapcore.Field
 type Core = zapcore.Core
 
+// level is shared by the suki cores so it can be changed at runtime.
+var level = zap.NewAtomicLevelAt(zapcore.DebugLevel)
+
+// Level returns the runtime log level of the suki cores, it also serves
+// GET and PUT requests to read and change the level over http.
+func Level() zap.AtomicLevel {
+        return level
+}
+
+// typeField collects the fields of a log call, the fields of a Logging
+// method are passed as one []interface{}. A ZapField or []ZapField is used
+// as it is, a string followed by a value is a key and value pair as Field
+// makes it, any other value is logged under the !BADKEY key. The caller
+// and function fields take 2 more.
 func typeField(fields ...interface{}) []ZapField {
-        f := make([]ZapField, 0)
+        n := 2
         for _, v := range fields {
-                val := v.([]interface{})
-                if len(val) > 0 {
-                        for _, field := range val {
-                                f = append(f, field.(ZapField))
-                        }
+                if val, ok := v.([]interface{}); ok {
+                        n += len(val)
                 }
         }
-        s := stack.Caller(3)
+        f := make([]ZapField, 0, n)
+        for _, v := range fields {
+                f = appendField(f, v)
+        }
+        return f
+}
+
+// appendCaller appends the caller and function fields of the log line
+// skip frames above the function calling it.
+func appendCaller(f []ZapField, skip int) []ZapField {
+        // runtime.Caller allocates its frames, a pc and its Func do not
+        var pc [1]uintptr
+        if runtime.Callers(skip+2, pc[:]) == 0 {
+                return f
+        }
+        fn := runtime.FuncForPC(pc[0] - 1)
+        if fn == nil {
+                return f
+        }
+        file, line := fn.FileLine(pc[0] - 1)
+        return appendFrame(f, file, line, fn)
+}
+
+// appendFrame appends the caller and function fields of a frame.
+func appendFrame(f []ZapField, file string, line int, fn *runtime.Func) []ZapField {
+        name := ""
+        if fn != nil {
+                name = funcName(fn.Name())
+        }
+        file = file[strings.LastIndexByte(file, '/')+1:]
         return append(f,
-                ZapField{Key: "caller", Type: zapcore.ReflectType, Interface: s},
-                ZapField{Key: "function", Type: zapcore.StringType, String: fmt.Sprintf("%s", s)},
+                ZapField{Key: "caller", Type: zapcore.StringType, String: file + ":" + strconv.Itoa(line)},
+                ZapField{Key: "function", Type: zapcore.StringType, String: name},
         )
 }
 
+func appendField(f []ZapField, v interface{}) []ZapField {
+        switch val := v.(type) {
+        case ZapField:
+                return append(f, val)
+        case []ZapField:
+                return append(f, val...)
+        case []interface{}:
+                for i := 0; i < len(val); i++ {
+                        switch field := val[i].(type) {
+                        case ZapField:
+                                f = append(f, field)
+                        case []ZapField:
+                                f = append(f, field...)
+                        case nil:
+                        case string:
+                                if i+1 < len(val) {
+                                        f = append(f, Any(field, val[i+1]))
+                                        i++
+                                        continue
+                                }
+                                f = append(f, zap.String(badKey, field))
+                        default:
+                                f = append(f, Any(badKey, field))
+                        }
+                }
+                return f
+        case nil:
+                return f
+        default:
+                return append(f, Any(badKey, val))
+        }
+}
+
+const badKey = "!BADKEY"
+
+// funcName returns the name of the function without its package path,
+// as the %n verb of go-stack.
+func funcName(name string) string {
+        name = name[strings.LastIndexByte(name, '/')+1:]
+        if i := strings.IndexByte(name, '.'); i != -1 {
+                name = name[i+1:]
+        }
+        return name
+}
+
+// callerLogger is a Logging able to log for a caller further up the
+// stack, as the package functions and the std log, grpclog and slog
+// adapters do. skip counts the frames above the function calling logSkip,
+// as runtime.Caller, pc is the program counter of the log line.
+type callerLogger interface {
+        logSkip(lvl zapcore.Level, skip int, msg string, fields []interface{})
+        logPC(lvl zapcore.Level, pc uintptr, msg string, fields []interface{})
+}
+
 // zapLog is the logger struct
 type zapLog struct {
-        logger *zap.Logger
+        logger   *zap.Logger
+        noCaller bool // skip the caller and function fields
 }
 
+// With returns a child logger with the fields, the logger itself is unchanged.
+// The caller is left to the lines logged by the child.
 func (z *zapLog) With(fields ...interface{}) Logging {
-        z.logger = z.logger.With(typeField(fields)...)
-        return z
+        return &zapLog{
+                logger:   z.Validator().With(typeField(fields)...),
+                noCaller: z.noCaller,
+        }
 }
 
 func (z *zapLog) Validator() *zap.Logger {
@@ -57,30 +158,98 @@ func (z *zapLog) Validator() *zap.Logger {
 }
 
 func (z *zapLog) Debug(msg string, fields ...interface{}) {
-        z.Validator().Debug(msg, typeField(fields)...)
+        z.logSkip(zapcore.DebugLevel, 1, msg, fields)
 }
 
 func (z *zapLog) Info(msg string, fields ...interface{}) {
-        z.Validator().Info(msg, typeField(fields)...)
+        z.logSkip(zapcore.InfoLevel, 1, msg, fields)
 }
 
 func (z *zapLog) Warn(msg string, fields ...interface{}) {
-        z.Validator().Warn(msg, typeField(fields)...)
+        z.logSkip(zapcore.WarnLevel, 1, msg, fields)
 }
 
 func (z *zapLog) Error(msg string, fields ...interface{}) {
-        z.Validator().Error(msg, typeField(fields)...)
+        z.logSkip(zapcore.ErrorLevel, 1, msg, fields)
 }
 
 func (z *zapLog) Fatal(msg string, fields ...interface{}) {
-        z.Validator().Fatal(msg, typeField(fields)...)
+        z.logSkip(zapcore.FatalLevel, 1, msg, fields)
 }
 
 func (z *zapLog) Panic(msg string, fields ...interface{}) {
-        z.Validator().Panic(msg, typeField(fields)...)
+        z.logSkip(zapcore.PanicLevel, 1, msg, fields)
+}
+
+func (z *zapLog) DebugFields(msg string, fields ...ZapField) {
+        z.logFields(zapcore.DebugLevel, 1, msg, fields)
+}
+
+func (z *zapLog) InfoFields(msg string, fields ...ZapField) {
+        z.logFields(zapcore.InfoLevel, 1, msg, fields)
+}
+
+func (z *zapLog) WarnFields(msg string, fields ...ZapField) {
+        z.logFields(zapcore.WarnLevel, 1, msg, fields)
+}
+
+func (z *zapLog) ErrorFields(msg string, fields ...ZapField) {
+        z.logFields(zapcore.ErrorLevel, 1, msg, fields)
+}
+
+func (z *zapLog) FatalFields(msg string, fields ...ZapField) {
+        z.logFields(zapcore.FatalLevel, 1, msg, fields)
+}
+
+func (z *zapLog) PanicFields(msg string, fields ...ZapField) {
+        z.logFields(zapcore.PanicLevel, 1, msg, fields)
+}
+
+func (z *zapLog) logSkip(lvl zapcore.Level, skip int, msg string, fields []interface{}) {
+        ce := z.Validator().Check(lvl, msg)
+        if ce == nil {
+                return
+        }
+        f := typeField(fields)
+        if !z.noCaller {
+                f = appendCaller(f, skip+1)
+        }
+        ce.Write(f...)
+}
+
+func (z *zapLog) logPC(lvl zapcore.Level, pc uintptr, msg string, fields []interface{}) {
+        ce := z.Validator().Check(lvl, msg)
+        if ce == nil {
+                return
+        }
+        f := typeField(fields)
+        if !z.noCaller && pc != 0 {
+                frame, _ := runtime.CallersFrames([]uintptr{pc}).Next()
+                f = appendFrame(f, frame.File, frame.Line, frame.Func)
+        }
+        ce.Write(f...)
+}
+
+// logFields logs the typed fields as they are, the caller fields are
+// appended to a copy, fields may be a slice of the caller.
+func (z *zapLog) logFields(lvl zapcore.Level, skip int, msg string, fields []ZapField) {
+        ce := z.Validator().Check(lvl, msg)
+        if ce == nil {
+                return
+        }
+        if !z.noCaller {
+                fields = appendCaller(append(make([]ZapField, 0, len(fields)+2), fields...), skip+1)
+        }
+        ce.Write(fields...)
 }
 
 func (z *zapLog) Field(key string, value interface{}) interface{} {
+        return Any(key, value)
+}
+
+// Any returns the field of the value by its type, as Field does,
+// without boxing the field into an interface{}.
+func Any(key string, value interface{}) ZapField {
         switch val := value.(type) {
         case zapcore.ObjectMarshaler:
                 return zap.Object(key, val)
@@ -196,31 +365,133 @@ func NewZapProductionEncoderConfig() zapcore.EncoderConfig {
 }
 
 func ProductionCore() Core {
-        defaultEncoder := zapcore.NewJSONEncoder(NewZapProductionEncoderConfig())
-        // First, define our level-handling logic.
-        highPriority := zap.LevelEnablerFunc(func(lvl zapcore.Level) bool {
-                return lvl >= zapcore.ErrorLevel
-        })
-        lowPriority := zap.LevelEnablerFunc(func(lvl zapcore.Level) bool {
-                return lvl < zapcore.ErrorLevel
-        })
-        // High-priority output should also go to standard error, and low-priority
-        // output should also go to standard out.
-        debugging := zapcore.Lock(os.Stdout)
-        errors := zapcore.Lock(os.Stderr)
-        return zapcore.NewTee(
-                zapcore.NewCore(defaultEncoder, errors, highPriority),
-                zapcore.NewCore(defaultEncoder, debugging, lowPriority),
-        )
+        return NewCore(Options{})
+}
+
+// Options configure the process wide logger, the zero value is
+// the JSON logger of ProductionCore.
+type Options struct {
+        Level         string                // debug, info, warn, error, ... the current level when empty
+        Encoding      string                // json, console or development, SUKI_LOG_ENCODING or json by default
+        Outputs       []zapcore.WriteSyncer // stdout and stderr for Error and above by default
+        Tee           []zapcore.WriteSyncer // also written with every level, e.g. a RotatingFile
+        Hooks         []*Hook               // receive the Error entries and above
+        Sampling      *Sampling             // no sampling when nil
+        DisableCaller bool                  // skip the caller and function fields
+        Redaction     *Redaction            // replaces the redaction of the suki cores when set
+        Stack         *StackOptions         // replaces the stack options of the Error entries when set
+}
+
+// Configure replaces the process wide logger with one built from the options.
+func Configure(opts Options) error {
+        if opts.Level != "" {
+                var lvl zapcore.Level
+                if err := lvl.UnmarshalText([]byte(opts.Level)); err != nil {
+                        return err
+                }
+                level.SetLevel(lvl)
+        }
+        switch opts.Encoding {
+        case "", "json", "console", EncodingDevelopment:
+        default:
+                return fmt.Errorf("unknown log encoding %q", opts.Encoding)
+        }
+        if opts.Redaction != nil {
+                SetRedaction(*opts.Redaction)
+        }
+        if opts.Stack != nil {
+                SetStackOptions(*opts.Stack)
+        }
+        SetLogger(newZapLog(opts))
+        return nil
+}
+
+// newZapLog builds the logger of the options.
+func newZapLog(opts Options) *zapLog {
+        return &zapLog{
+                logger:   zap.New(NewCore(opts)),
+                noCaller: opts.DisableCaller,
+        }
+}
+
+func logEncoding(opts Options) string {
+        if opts.Encoding != "" {
+                return opts.Encoding
+        }
+        return os.Getenv(EnvLogEncoding)
+}
+
+// NewCore builds the core of the options, leveled by the shared Level.
+// Its Error entries and above carry a stack trace and the errorChain
+// of their errors, see ErrorCore.
+func NewCore(opts Options) Core {
+        var encoder zapcore.Encoder
+        switch logEncoding(opts) {
+        case "console":
+                encoder = zapcore.NewConsoleEncoder(NewZapProductionEncoderConfig())
+        case EncodingDevelopment:
+                encoder = NewDevelopmentEncoder()
+        default:
+                encoder = zapcore.NewJSONEncoder(NewZapProductionEncoderConfig())
+        }
+        var core Core
+        if len(opts.Outputs) > 0 {
+                cores := make([]Core, 0, len(opts.Outputs))
+                for _, out := range opts.Outputs {
+                        cores = append(cores, leafCore(zapcore.NewCore(encoder, out, level)))
+                }
+                core = zapcore.NewTee(cores...)
+        } else {
+                // First, define our level-handling logic.
+                highPriority := zap.LevelEnablerFunc(func(lvl zapcore.Level) bool {
+                        return lvl >= zapcore.ErrorLevel && level.Enabled(lvl)
+                })
+                lowPriority := zap.LevelEnablerFunc(func(lvl zapcore.Level) bool {
+                        return lvl < zapcore.ErrorLevel && level.Enabled(lvl)
+                })
+                // High-priority output should also go to standard error, and low-priority
+                // output should also go to standard out.
+                debugging := zapcore.Lock(os.Stdout)
+                errors := zapcore.Lock(os.Stderr)
+                core = zapcore.NewTee(
+                        leafCore(zapcore.NewCore(encoder, errors, highPriority)),
+                        leafCore(zapcore.NewCore(encoder, debugging, lowPriority)),
+                )
+        }
+        if len(opts.Tee) > 0 {
+                cores := []Core{core}
+                for _, out := range opts.Tee {
+                        cores = append(cores, leafCore(zapcore.NewCore(encoder, out, level)))
+                }
+                core = zapcore.NewTee(cores...)
+        }
+        if len(opts.Hooks) > 0 {
+                cores := []Core{core}
+                for _, h := range opts.Hooks {
+                        cores = append(cores, leafCore(h.Core()))
+                }
+                core = zapcore.NewTee(cores...)
+        }
+        // above the Tee, the stack of an Error entry is captured once
+        core = ErrorCore(core, nil)
+        if opts.Sampling != nil {
+                core = SampleCore(core, *opts.Sampling)
+        }
+        return core
+}
+
+// leafCore wraps a leaf core of NewCore.
+func leafCore(core Core) Core {
+        return RedactCore(core, nil)
 }
 
 func DefaultCore(out zapcore.WriteSyncer) Core {
         if out == nil {
                 out = os.Stdout
         }
-        return zapcore.NewCore(
+        return RedactCore(zapcore.NewCore(
                 zapcore.NewJSONEncoder(NewZapProductionEncoderConfig()),
                 out,
-                zap.DebugLevel,
-        )
+                level,
+        ), nil)
 }
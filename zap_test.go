@@ -10,7 +10,10 @@ package suki
 
 import (
         "bytes"
+        "errors"
         "io"
+        "io/ioutil"
+        "log/slog"
         "net"
         "os"
         "strings"
@@ -65,10 +68,10 @@ func TestZapStdOutInit(t *testing.T) {
         os.Stdout = old
         out := <-outC
         expected := []string{
-                `{"level":"info","msg":"received work order",` + LoggerStackCaller(827, "") + `}`,
-                `{"level":"debug","msg":"starting work",` + LoggerStackCaller(827, "") + `}`,
-                `{"level":"warn","msg":"work may fail",` + LoggerStackCaller(827, "") + `}`,
-                `{"level":"error","msg":"work failed",` + LoggerStackCaller(827, "") + `}`,
+                `{"level":"info","msg":"received work order",` + LoggerStackCaller(54, "") + `}`,
+                `{"level":"debug","msg":"starting work",` + LoggerStackCaller(55, "") + `}`,
+                `{"level":"warn","msg":"work may fail",` + LoggerStackCaller(56, "") + `}`,
+                `{"level":"error","msg":"work failed",` + LoggerStackCaller(57, "") + `}`,
         }
         assert.Equal(t,
                 strings.Join(expected, "\n")+"\n",
@@ -97,7 +100,7 @@ func TestZapStdErrInit(t *testing.T) {
         os.Stderr = old
         out := <-outC
         expected := []string{
-                `{"level":"error","msg":"work failed",` + LoggerStackCaller(827, "") + `}`,
+                `{"level":"error","msg":"work failed",` + LoggerStackCaller(89, "") + `}`,
         }
         assert.Equal(t,
                 strings.Join(expected, "\n")+"\n",
@@ -115,10 +118,10 @@ func TestInternalZap(t *testing.T) {
         log.Error("work failed")
 
         ts.AssertMessages(
-                `{"level":"info","msg":"received work order",`+LoggerStackCaller(827, "")+`}`,
-                `{"level":"debug","msg":"starting work",`+LoggerStackCaller(827, "")+`}`,
-                `{"level":"warn","msg":"work may fail",`+LoggerStackCaller(827, "")+`}`,
-                `{"level":"error","msg":"work failed",`+LoggerStackCaller(827, "")+`}`,
+                `{"level":"info","msg":"received work order",`+LoggerStackCaller(115, "")+`}`,
+                `{"level":"debug","msg":"starting work",`+LoggerStackCaller(116, "")+`}`,
+                `{"level":"warn","msg":"work may fail",`+LoggerStackCaller(117, "")+`}`,
+                `{"level":"error","msg":"work failed",`+LoggerStackCaller(118, "")+`}`,
         )
 
         assert.Panics(t, func() {
@@ -150,10 +153,10 @@ func TestInternalStdout(t *testing.T) {
         os.Stdout = old
         out := <-outC
         expected := []string{
-                `{"level":"info","msg":"received work order",` + LoggerStackCaller(827, "") + `}`,
-                `{"level":"debug","msg":"starting work",` + LoggerStackCaller(827, "") + `}`,
-                `{"level":"warn","msg":"work may fail",` + LoggerStackCaller(827, "") + `}`,
-                `{"level":"error","msg":"work failed",` + LoggerStackCaller(827, "") + `}`,
+                `{"level":"info","msg":"received work order",` + LoggerStackCaller(139, "") + `}`,
+                `{"level":"debug","msg":"starting work",` + LoggerStackCaller(140, "") + `}`,
+                `{"level":"warn","msg":"work may fail",` + LoggerStackCaller(141, "") + `}`,
+                `{"level":"error","msg":"work failed",` + LoggerStackCaller(142, "") + `}`,
         }
         assert.Equal(t,
                 strings.Join(expected, "\n")+"\n",
@@ -209,6 +212,92 @@ func TestZapField(t *testing.T) {
         }
 }
 
+func TestTypedFields(t *testing.T) {
+        log, ts := newZap(t)
+        log.noCaller = true
+        log.Info("order delivered",
+                String("order", "INV-7"),
+                Int("items", 3),
+                Bool("paid", true),
+                Duration("took", 1500*time.Millisecond),
+                Err(errors.New("late")),
+        )
+        ts.AssertMessages(`{"level":"info","msg":"order delivered","order":"INV-7","items":3,"paid":true,"took":1.5,"error":"late"}`)
+}
+
+func TestFieldLogging(t *testing.T) {
+        log, ts := newZap(t)
+        Fields(log).InfoFields("order delivered", String("order", "INV-7"), Int("items", 3))
+        assert.Len(t, ts.Messages, 1)
+        assert.Contains(t, ts.Messages[0], `"msg":"order delivered","order":"INV-7","items":3,"caller":"zap_test.go:`)
+        assert.Contains(t, ts.Messages[0], `"function":"TestFieldLogging"`)
+
+        var buf bytes.Buffer
+        Fields(FromSlog(slog.New(slog.NewJSONHandler(&buf, nil)))).WarnFields("order late", String("order", "INV-7"))
+        assert.Contains(t, buf.String(), `"msg":"order late","order":"INV-7"`)
+}
+
+func TestLooseFields(t *testing.T) {
+        log, ts := newZap(t)
+        log.noCaller = true
+        assert.NotPanics(t, func() {
+                log.Info("received work order", "courier", "phil", 42, nil, []ZapField{Int("n", 1)}, "dangling")
+        })
+        ts.AssertMessages(`{"level":"info","msg":"received work order","courier":"phil","!BADKEY":42,"n":1,"!BADKEY":"dangling"}`)
+}
+
+func TestCallerFunction(t *testing.T) {
+        old := Current()
+        defer SetLogger(old)
+        log, ts := newZap(t)
+        SetLogger(log)
+        Info("received work order")
+        assert.Len(t, ts.Messages, 1)
+        assert.Contains(t, ts.Messages[0], `"caller":"zap_test.go:`)
+        assert.Contains(t, ts.Messages[0], `"function":"TestCallerFunction"`)
+}
+
+func benchZap(noCaller bool) *zapLog {
+        return &zapLog{
+                logger:   zap.New(DefaultCore(zapcore.AddSync(ioutil.Discard))),
+                noCaller: noCaller,
+        }
+}
+
+func BenchmarkField(b *testing.B) {
+        log := benchZap(false)
+        b.ReportAllocs()
+        for i := 0; i < b.N; i++ {
+                log.Info("order delivered", Field("order", "INV-7"), Field("items", 3), Field("took", time.Second))
+        }
+}
+
+// BenchmarkTypedFieldsBoxed logs the typed fields through the Logging
+// method, each one is boxed into an interface{} as in BenchmarkField.
+func BenchmarkTypedFieldsBoxed(b *testing.B) {
+        log := benchZap(false)
+        b.ReportAllocs()
+        for i := 0; i < b.N; i++ {
+                log.Info("order delivered", String("order", "INV-7"), Int("items", 3), Duration("took", time.Second))
+        }
+}
+
+func BenchmarkTypedFields(b *testing.B) {
+        log := Fields(benchZap(false))
+        b.ReportAllocs()
+        for i := 0; i < b.N; i++ {
+                log.InfoFields("order delivered", String("order", "INV-7"), Int("items", 3), Duration("took", time.Second))
+        }
+}
+
+func BenchmarkTypedFieldsNoCaller(b *testing.B) {
+        log := Fields(benchZap(true))
+        b.ReportAllocs()
+        for i := 0; i < b.N; i++ {
+                log.InfoFields("order delivered", String("order", "INV-7"), Int("items", 3), Duration("took", time.Second))
+        }
+}
+
 type username string
 
 func (n username) MarshalLogObject(enc zapcore.ObjectEncoder) error {